@@ -32,7 +32,7 @@
           iface.enable = lib.mkForce ["identical"];
           importas.alias = [
             {
-              pkg = "github.com/krostar/cli/mapper/internal";
+              pkg = "github.com/krostar/cli/internal/mapper";
               alias = "mapper";
             }
             {
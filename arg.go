@@ -0,0 +1,146 @@
+package cli
+
+import "strings"
+
+// Arg represents a named command-line argument. It combines the FlagValuer
+// interface, used to parse the raw argument, with methods to access the
+// argument metadata (name, description).
+type Arg interface {
+	// FlagValuer defines methods to get and set argument's value.
+	FlagValuer
+
+	// Name returns the name of the argument, displayed in usage, help and errors.
+	Name() string
+	// Description returns a description of the argument's purpose.
+	Description() string
+}
+
+// NewArgWithValuer creates a new Arg instance.
+//
+//	name is the argument name, like "port" ; cannot be empty.
+//	valuer provide the way to set value to the destination ; when nil, the argument is parsed as a string.
+//	description is a short text explaining the argument ; can be empty.
+//
+// It panics if invalid inputs are provided.
+func NewArgWithValuer(name string, valuer FlagValuer, description string) Arg {
+	if name == "" {
+		panic("name must be non-empty")
+	}
+
+	if valuer == nil {
+		valuer = NewFlagValuer(new(string),
+			func(raw string) (string, error) { return raw, nil },
+			func(value string) string { return value },
+		)
+	}
+
+	return &argValue{
+		FlagValuer: valuer,
+
+		name:        name,
+		description: description,
+	}
+}
+
+type argValue struct {
+	FlagValuer
+
+	name        string
+	description string
+}
+
+func (a argValue) Name() string        { return a.name }
+func (a argValue) Description() string { return a.description }
+
+// DefaultString implements FlagDefault ; it returns the current value of valuers not implementing it.
+func (a argValue) DefaultString() string { return valuerDefaultString(a.FlagValuer) }
+
+// ResetToDefault implements FlagDefault ; it does nothing for valuers not implementing it.
+func (a argValue) ResetToDefault() { resetValuerToDefault(a.FlagValuer) }
+
+// ArgsSpec describes the arguments accepted by a command.
+// Mappers reject inconsistent specifications when commands are built, and parse and
+// validate the arguments against it before the command is executed.
+type ArgsSpec struct {
+	// Positional describes the arguments provided before "--" ; when nil, they are not validated.
+	Positional *ArgList
+	// Dashed describes the arguments provided after "--" ; when nil, they are not validated.
+	Dashed *ArgList
+}
+
+// ArgList describes an ordered list of arguments.
+type ArgList struct {
+	// Args are the named arguments, in the order they are expected.
+	// All of them are required, except the last Optional ones.
+	Args []Arg
+	// Optional is the number of trailing Args that can be omitted.
+	Optional int
+	// Variadic, when set, accepts additional arguments after Args, each of
+	// them being parsed in order by it. Otherwise, extra arguments are rejected.
+	Variadic Arg
+	// MinVariadic is the minimum number of variadic arguments.
+	MinVariadic int
+	// MaxVariadic is the maximum number of variadic arguments ; zero means unlimited.
+	MaxVariadic int
+}
+
+// Usage returns the usage representation of the arguments, where
+// required arguments are written <name> and optional ones [name].
+func (l ArgList) Usage() string {
+	var usage []string
+
+	for i, arg := range l.Args {
+		if i < len(l.Args)-l.Optional {
+			usage = append(usage, "<"+arg.Name()+">")
+		} else {
+			usage = append(usage, "["+arg.Name()+"]")
+		}
+	}
+
+	if l.Variadic != nil {
+		if l.MinVariadic > 0 {
+			usage = append(usage, "<"+l.Variadic.Name()+">...")
+		} else {
+			usage = append(usage, "["+l.Variadic.Name()+"...]")
+		}
+	}
+
+	return strings.Join(usage, " ")
+}
+
+// Usage returns the usage representation of the positional and dashed arguments.
+func (spec ArgsSpec) Usage() string {
+	var usage []string
+
+	if spec.Positional != nil {
+		if positional := spec.Positional.Usage(); positional != "" {
+			usage = append(usage, positional)
+		}
+	}
+
+	if spec.Dashed != nil {
+		if dashed := spec.Dashed.Usage(); dashed != "" {
+			usage = append(usage, "--", dashed)
+		}
+	}
+
+	return strings.Join(usage, " ")
+}
+
+// All returns the positional and dashed arguments, including the variadic ones, in order.
+func (spec ArgsSpec) All() []Arg {
+	var args []Arg
+
+	for _, list := range []*ArgList{spec.Positional, spec.Dashed} {
+		if list == nil {
+			continue
+		}
+
+		args = append(args, list.Args...)
+		if list.Variadic != nil {
+			args = append(args, list.Variadic)
+		}
+	}
+
+	return args
+}
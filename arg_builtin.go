@@ -0,0 +1,38 @@
+package cli
+
+// NewArg creates an Arg for built-in types (int, string, bool, etc.).
+// Arguments are parsed the same way flags created by NewBuiltinFlag are.
+// See NewArgWithValuer for more details.
+//
+// Example:
+//
+//	cli.NewArg("port", &cmd.cfg.Port, "listen port")
+func NewArg[T builtins](name string, destination *T, description string) Arg {
+	return NewArgWithValuer(name, NewFlagValuer(destination, builtinFromString[T], builtinToString[T]), description)
+}
+
+// NewVariadicArg creates an Arg for slices of built-in types, meant to be used as
+// an ArgList variadic argument: each argument is parsed and appended to the destination.
+// The destination is reset when the first argument is parsed, until the argument is reset to its default.
+// See NewArgWithValuer for more details.
+func NewVariadicArg[T builtins](name string, destination *[]T, description string) Arg {
+	var valuer FlagValuer
+
+	valuer = NewFlagValuer(destination,
+		func(raw string) ([]T, error) {
+			value, err := builtinFromString[T](raw)
+			if err != nil {
+				return nil, err
+			}
+
+			if !valuer.IsSet() {
+				return []T{value}, nil
+			}
+
+			return append(*destination, value), nil
+		},
+		builtinSliceToString[T],
+	)
+
+	return NewArgWithValuer(name, valuer, description)
+}
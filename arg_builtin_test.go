@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+)
+
+func Test_NewArg(t *testing.T) {
+	var value int
+
+	arg := NewArg("name", &value, "description")
+	test.Assert(t, arg.Name() == "name")
+	test.Assert(t, arg.Description() == "description")
+	test.Assert(t, arg.TypeRepr() == "int")
+	test.Assert(t, !arg.IsSet())
+
+	err := arg.FromString("abc")
+	test.Assert(t, err != nil && strings.Contains(err.Error(), "invalid syntax"))
+
+	test.Assert(t, arg.FromString("42") == nil)
+	test.Assert(t, value == 42 && arg.IsSet())
+	test.Assert(t, arg.String() == "42")
+}
+
+func Test_NewVariadicArg(t *testing.T) {
+	value := []int{1, 2}
+
+	arg := NewVariadicArg("name", &value, "description")
+	test.Assert(t, arg.TypeRepr() == "[]int")
+
+	err := arg.FromString("abc")
+	test.Assert(t, err != nil && strings.Contains(err.Error(), "invalid syntax"))
+
+	test.Assert(t, arg.FromString("42") == nil)
+	test.Assert(t, arg.FromString("44") == nil)
+	test.Assert(check.Compare(t, value, []int{42, 44}))
+	test.Assert(t, arg.String() == "[42,44]")
+
+	arg.(FlagDefault).ResetToDefault()
+	test.Assert(check.Compare(t, value, []int{1, 2}))
+
+	test.Assert(t, arg.FromString("46") == nil)
+	test.Assert(check.Compare(t, value, []int{46}))
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+)
+
+func Test_NewArgWithValuer(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		var value int
+
+		arg := NewArgWithValuer("port", NewFlagValuer(&value, strconv.Atoi, strconv.Itoa), "listen port")
+		test.Assert(t, arg.Name() == "port")
+		test.Assert(t, arg.Description() == "listen port")
+		test.Assert(t, arg.TypeRepr() == "int")
+
+		test.Assert(t, arg.FromString("42") == nil)
+		test.Assert(t, value == 42 && arg.IsSet())
+	})
+
+	t.Run("nil valuer", func(t *testing.T) {
+		arg := NewArgWithValuer("name", nil, "")
+		test.Assert(t, arg.TypeRepr() == "string")
+		test.Assert(t, !arg.IsSet())
+
+		test.Assert(t, arg.FromString("bob") == nil)
+		test.Assert(t, arg.String() == "bob" && arg.IsSet())
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		test.Assert(check.Panics(t, func() {
+			NewArgWithValuer("", nil, "")
+		}, func(reason any) error {
+			if strings.Contains(reason.(string), "name must be non-empty") {
+				return nil
+			}
+
+			return errors.New("expected different panic reason")
+		}))
+	})
+}
+
+func Test_ArgsSpec_Usage(t *testing.T) {
+	a, b, c := NewArgWithValuer("a", nil, ""), NewArgWithValuer("b", nil, ""), NewArgWithValuer("c", nil, "")
+
+	for name, tt := range map[string]struct {
+		spec     ArgsSpec
+		expected string
+	}{
+		"empty": {
+			spec:     ArgsSpec{},
+			expected: "",
+		},
+		"required and optional": {
+			spec:     ArgsSpec{Positional: &ArgList{Args: []Arg{a, b}, Optional: 1}},
+			expected: "<a> [b]",
+		},
+		"required variadic": {
+			spec:     ArgsSpec{Positional: &ArgList{Args: []Arg{a}, Variadic: b, MinVariadic: 1}},
+			expected: "<a> <b>...",
+		},
+		"optional variadic": {
+			spec:     ArgsSpec{Positional: &ArgList{Variadic: b}},
+			expected: "[b...]",
+		},
+		"positional and dashed": {
+			spec:     ArgsSpec{Positional: &ArgList{Args: []Arg{a}}, Dashed: &ArgList{Args: []Arg{b}, Variadic: c}},
+			expected: "<a> -- <b> [c...]",
+		},
+		"dashed only": {
+			spec:     ArgsSpec{Positional: &ArgList{}, Dashed: &ArgList{Variadic: c}},
+			expected: "-- [c...]",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.Assert(t, tt.spec.Usage() == tt.expected, "got %q", tt.spec.Usage())
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ByteSize is a number of bytes, represented by humans like "512B", "10MiB" or "1.5GB".
+type ByteSize uint64
+
+// byteSizeUnits are the supported units, from the largest to the smallest, binary units first.
+//
+//nolint:gochecknoglobals // read-only table
+var byteSizeUnits = []struct {
+	name  string
+	bytes uint64
+}{
+	{name: "EiB", bytes: 1 << 60},
+	{name: "EB", bytes: 1e18},
+	{name: "PiB", bytes: 1 << 50},
+	{name: "PB", bytes: 1e15},
+	{name: "TiB", bytes: 1 << 40},
+	{name: "TB", bytes: 1e12},
+	{name: "GiB", bytes: 1 << 30},
+	{name: "GB", bytes: 1e9},
+	{name: "MiB", bytes: 1 << 20},
+	{name: "MB", bytes: 1e6},
+	{name: "KiB", bytes: 1 << 10},
+	{name: "kB", bytes: 1e3},
+	{name: "B", bytes: 1},
+}
+
+// ParseByteSize parses a human representation of a number of bytes, like "10MiB".
+// Both binary (KiB, MiB, ..., EiB) and decimal (kB, MB, ..., EB) units are supported,
+// case-insensitively ; without unit, the number is a number of bytes.
+// The number can be decimal ("1.5GiB") as long as it represents a whole number of bytes.
+func ParseByteSize(raw string) (ByteSize, error) {
+	raw = strings.TrimSpace(raw)
+
+	numberEndsAt := strings.IndexFunc(raw, func(r rune) bool { return r != '.' && !unicode.IsDigit(r) })
+	if numberEndsAt < 0 {
+		numberEndsAt = len(raw)
+	}
+
+	rawNumber, rawUnit := raw[:numberEndsAt], strings.TrimSpace(raw[numberEndsAt:])
+	if rawNumber == "" {
+		return 0, fmt.Errorf("invalid byte size %q: missing number", raw)
+	}
+
+	unit := uint64(1)
+	if rawUnit != "" {
+		found := false
+
+		for _, u := range byteSizeUnits {
+			if strings.EqualFold(u.name, rawUnit) {
+				unit, found = u.bytes, true
+				break
+			}
+		}
+
+		if !found {
+			return 0, fmt.Errorf("invalid byte size %q: unknown unit %q", raw, rawUnit)
+		}
+	}
+
+	if !strings.Contains(rawNumber, ".") {
+		number, err := strconv.ParseUint(rawNumber, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid byte size %q: %w", raw, err)
+		}
+
+		if number > math.MaxUint64/unit {
+			return 0, fmt.Errorf("invalid byte size %q: %w", raw, strconv.ErrRange)
+		}
+
+		return ByteSize(number * unit), nil
+	}
+
+	number, err := strconv.ParseFloat(rawNumber, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size %q: %w", raw, err)
+	}
+
+	bytes := number * float64(unit)
+	if bytes >= math.MaxUint64 {
+		return 0, fmt.Errorf("invalid byte size %q: %w", raw, strconv.ErrRange)
+	}
+
+	if bytes != math.Trunc(bytes) {
+		return 0, fmt.Errorf("invalid byte size %q: not a whole number of bytes", raw)
+	}
+
+	return ByteSize(bytes), nil
+}
+
+// String returns the representation of the size with the largest unit representing it exactly.
+func (size ByteSize) String() string {
+	for _, u := range byteSizeUnits {
+		if size != 0 && uint64(size)%u.bytes == 0 {
+			return strconv.FormatUint(uint64(size)/u.bytes, 10) + u.name
+		}
+	}
+
+	return "0B"
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (size ByteSize) MarshalText() ([]byte, error) {
+	return []byte(size.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (size *ByteSize) UnmarshalText(text []byte) error {
+	parsed, err := ParseByteSize(string(text))
+	if err != nil {
+		return err
+	}
+
+	*size = parsed
+
+	return nil
+}
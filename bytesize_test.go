@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+)
+
+func Test_ParseByteSize(t *testing.T) {
+	for raw, expected := range map[string]ByteSize{
+		"0":                    0,
+		"512":                  512,
+		"512B":                 512,
+		"1kB":                  1000,
+		"1KB":                  1000,
+		"1KiB":                 1024,
+		"1kib":                 1024,
+		"10 MiB":               10 << 20,
+		"10MB":                 10_000_000,
+		"1.5GiB":               3 << 29,
+		"0.5KiB":               512,
+		" 3 GB  ":              3e9,
+		"2TiB":                 2 << 40,
+		"1PB":                  1e15,
+		"15EB":                 15e18,
+		"18446744073709551615": 18446744073709551615,
+	} {
+		t.Run(raw, func(t *testing.T) {
+			size, err := ParseByteSize(raw)
+			test.Require(t, err == nil, err)
+			test.Assert(t, size == expected, size)
+		})
+	}
+
+	for raw, expectedErr := range map[string]string{
+		"":                     "missing number",
+		"MiB":                  "missing number",
+		"10XB":                 `unknown unit "XB"`,
+		"1.2.3":                "invalid syntax",
+		"1.0001kB":             "not a whole number of bytes",
+		"16EiB":                "value out of range",
+		"20EB":                 "value out of range",
+		"17.5EiB":              "value out of range",
+		"18446744073709551616": "value out of range",
+	} {
+		t.Run("invalid "+raw, func(t *testing.T) {
+			_, err := ParseByteSize(raw)
+			test.Assert(t, err != nil && strings.Contains(err.Error(), expectedErr), err)
+		})
+	}
+}
+
+func Test_ByteSize_String(t *testing.T) {
+	for size, expected := range map[ByteSize]string{
+		0:         "0B",
+		1:         "1B",
+		1000:      "1kB",
+		1024:      "1KiB",
+		1500:      "1500B",
+		10 << 20:  "10MiB",
+		3e9:       "3GB",
+		1<<60 + 1: "1152921504606846977B",
+	} {
+		test.Assert(t, size.String() == expected, size.String())
+	}
+}
+
+func Test_ByteSize_Text(t *testing.T) {
+	var size ByteSize
+
+	test.Require(t, size.UnmarshalText([]byte("2MiB")) == nil)
+	test.Assert(t, size == 2<<20)
+
+	text, err := size.MarshalText()
+	test.Require(t, err == nil)
+	test.Assert(t, string(text) == "2MiB")
+
+	test.Assert(t, size.UnmarshalText([]byte("nope")) != nil)
+	test.Assert(t, size == 2<<20)
+}
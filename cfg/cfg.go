@@ -23,6 +23,9 @@ type SourceFunc[T any] func(ctx context.Context, cfg *T) error
 // with later sources overriding values from earlier ones if they provide the same setting.
 // Returns a cli.HookFunc that can be used as a BeforeCommandExecution hook.
 //
+// When the flags are sourced with sourceflag.Source, required flags whose value is provided by
+// the other sources are considered provided, even if they were not set on the command line (see cli.WithRequired).
+//
 // Example:
 //
 //	func (cmd *MyCommand) Hook() *cli.Hook {
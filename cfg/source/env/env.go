@@ -2,13 +2,20 @@ package sourceenv
 
 import (
 	"context"
+	"encoding"
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
 	"os"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/krostar/cli"
 	clicfg "github.com/krostar/cli/cfg"
 )
 
@@ -16,36 +23,79 @@ import (
 // It uses reflection to traverse the config struct and sets fields based on environment variables.
 // The environment variable names are derived from the struct field names, converted to uppercase and
 // with nested fields separated by underscores. A struct field can specify additional environment
-// variable names using the `env` tag, comma separated.
+// variable names using the `env` tag, comma separated. Types implementing encoding.TextUnmarshaler
+// are decoded from a single environment variable, like primitive types.
+//
+// Slices are split the way slice flags are, see cli.SplitValues: values are comma-separated by default,
+// the separator can be set with the `envsep` tag (an empty one disables the splitting), and values are
+// parsed like a CSV record with the `envcsv:"true"` tag, matching the WithSeparator and WithCSV flag options.
+//
+// Maps are populated from a single variable encoding the entries, like APP_LABELS="k1=v1,k2=v2",
+// and from the family of variables prefixed by the map name, like APP_LABELS_K3=v3, the key being
+// the remaining part of the variable name ; entries of the family take precedence.
+//
+// Values can also be read from files, following the convention used for container secrets: when a variable
+// is not set, the variable suffixed by _FILE, like APP_DB_PASSWORD_FILE, is the path of the file holding the
+// value. Files are read like flags created with cli.WithFileValue do, see cli.ReadFileValue. As a consequence,
+// FILE cannot be used as a key of the family of variables of maps.
 func Source[T any](envPrefix string) clicfg.SourceFunc[T] {
 	return func(_ context.Context, cfg *T) error {
-		_, err := recursivelyWalkThroughReflectValue(os.LookupEnv, reflect.ValueOf(cfg).Elem(), envPrefix, nil)
+		_, err := recursivelyWalkThroughReflectValue(environment{
+			lookup:   os.LookupEnv,
+			list:     os.Environ,
+			readFile: func(path string) (string, error) { return cli.ReadFileValue(path, cli.DefaultMaxFileValueSize) },
+		}, reflect.ValueOf(cfg).Elem(), envPrefix, "")
 
 		return err
 	}
 }
 
+// environment gives access to the environment variables.
+type environment struct {
+	// lookup retrieves the value of an environment variable, like os.LookupEnv.
+	lookup func(string) (string, bool)
+	// list returns all the environment variables, in the form "key=value", like os.Environ.
+	list func() []string
+	// readFile returns the value stored in a file, like cli.ReadFileValue.
+	readFile func(string) (string, error)
+}
+
+// fileSuffix is the suffix of the environment variables holding the path of the file storing the value.
+const fileSuffix = "_FILE"
+
 // recursivelyWalkThroughReflectValue recursively traverses a reflect.Value and sets fields from environment variables.
 //
-//	lookupEnv is a function to lookup environment variables.
+//	env gives access to environment variables.
 //	v is the current reflect.Value being processed.
 //	envPrefix is the prefix for the environment variable names.
-//	additionalEnvsToLookup are additional environment variable names specified in the `env` tag.
+//	tag is the tag of the struct field being processed, if any, specifying additional environment
+//	variable names in its `env` key, and the way slices and maps are split in its `envsep` and `envcsv` keys.
 //
 // It returns a boolean indicating if at least one environment variable was found and an error if any occurred.
-func recursivelyWalkThroughReflectValue(lookupEnv func(string) (string, bool), v reflect.Value, envPrefix string, additionalEnvsToLookup []string) (bool, error) {
+func recursivelyWalkThroughReflectValue(env environment, v reflect.Value, envPrefix string, tag reflect.StructTag) (bool, error) {
 	t := v.Type()
+	envsToLookup := append(strings.Split(tag.Get("env"), ","), envPrefix)
+
+	// standard library types and types implementing encoding.TextUnmarshaler are decoded like primitive types
+	if _, isStdlibType := stdlibTypesParsers[t]; isStdlibType || isTextUnmarshaler(v) {
+		rawEnv, found, err := lookupFirstEnv(env, envsToLookup)
+		if !found || err != nil {
+			return found, err
+		}
+
+		return true, setValueFromString(v, rawEnv)
+	}
 
 	switch t.Kind() {
 	case reflect.Pointer: // if it's a pointer, dereference it and continue recursively
 		if !v.IsNil() {
-			return recursivelyWalkThroughReflectValue(lookupEnv, v.Elem(), envPrefix, additionalEnvsToLookup)
+			return recursivelyWalkThroughReflectValue(env, v.Elem(), envPrefix, tag)
 		}
 
 		// if the pointer is nil, create a new value of the underlying type
 		newV := reflect.New(v.Type().Elem())
 		// recursively process the new value
-		atLeastOneEnvFound, err := recursivelyWalkThroughReflectValue(lookupEnv, newV.Elem(), envPrefix, additionalEnvsToLookup)
+		atLeastOneEnvFound, err := recursivelyWalkThroughReflectValue(env, newV.Elem(), envPrefix, tag)
 		// if at least one environment variable was found for the nested struct, set the pointer
 		if atLeastOneEnvFound {
 			v.Set(newV)
@@ -61,11 +111,11 @@ func recursivelyWalkThroughReflectValue(lookupEnv func(string) (string, bool), v
 
 		for i := range v.NumField() {
 			tfield := t.Field(i)
-			tag := tfield.Tag.Get("env")
+			envTag := tfield.Tag.Get("env")
 
 			embededStruct := tfield.Anonymous && tfield.Type.Kind() == reflect.Struct
 			unexported := tfield.PkgPath != ""
-			skipped := tag == "-"
+			skipped := envTag == "-"
 
 			// skip unexported fields that are not embedded structs, and fields with `env:"-"`
 			if skipped || (unexported && !embededStruct) {
@@ -73,12 +123,12 @@ func recursivelyWalkThroughReflectValue(lookupEnv func(string) (string, bool), v
 			}
 
 			newEnvPrefix := envPrefix + "_" + strings.ToUpper(tfield.Name)
-			if embededStruct && tag == "^" {
+			if embededStruct && envTag == "^" {
 				newEnvPrefix = envPrefix
 			}
 
 			// recursively process each field, constructing the environment variable name
-			envFound, err := recursivelyWalkThroughReflectValue(lookupEnv, v.Field(i), newEnvPrefix, strings.Split(tag, ","))
+			envFound, err := recursivelyWalkThroughReflectValue(env, v.Field(i), newEnvPrefix, tfield.Tag)
 			if envFound {
 				atLeastOneFound = true
 			}
@@ -88,106 +138,299 @@ func recursivelyWalkThroughReflectValue(lookupEnv func(string) (string, bool), v
 
 		return atLeastOneFound, errors.Join(errs...)
 
-	default: // for primitive types, try to find the corresponding environment variable
-		var rawEnv string
-
-		for _, envToLookup := range append(additionalEnvsToLookup, envPrefix) {
-			envToLookup = strings.TrimSpace(envToLookup)
-			if envToLookup != "" {
-				if env, isset := lookupEnv(SanitizeName(envToLookup)); isset {
-					rawEnv = env
-					break
-				}
-			}
+	case reflect.Map: // if it's a map, gather entries from the encoded variable and the family of prefixed variables
+		return setMapFromEnv(env, v, envsToLookup, tag)
+
+	case reflect.Slice: // if it's a slice, split the environment variable the way slice flags do
+		rawEnv, found, err := lookupFirstEnv(env, envsToLookup)
+		if !found || err != nil {
+			return found, err
 		}
+
+		return true, setSliceFromString(v, rawEnv, tag)
+
+	default: // for primitive types, try to find the corresponding environment variable
+		rawEnv, found, err := lookupFirstEnv(env, envsToLookup)
 		// no environment variable is found, return
-		if rawEnv == "" {
-			return false, nil
+		if !found || err != nil {
+			return found, err
 		}
 
-		// convert the environment variable value to the field's type and set it
-		switch k := t.Kind(); k {
-		case reflect.Bool:
-			vv, err := strconv.ParseBool(rawEnv)
-			v.SetBool(vv)
+		return true, setValueFromString(v, rawEnv)
+	}
+}
 
-			return true, err
-		case reflect.Int:
-			vv, err := strconv.ParseInt(rawEnv, 10, 0)
-			v.SetInt(vv)
+// setMapFromEnv replaces the map v by the entries found in the environment variables named after
+// envsToLookup, encoded like "k1=v1,k2=v2", and in the family of variables prefixed by these names.
+// Entries are split according to the provided field tag, see sliceSplitting.
+// It returns a boolean indicating if at least one entry was found and an error if any occurred.
+func setMapFromEnv(env environment, v reflect.Value, envsToLookup []string, tag reflect.StructTag) (bool, error) {
+	var entries [][2]string
 
-			return true, err
-		case reflect.Int8:
-			vv, err := strconv.ParseInt(rawEnv, 10, 8)
-			v.SetInt(vv)
+	rawEnv, found, err := lookupFirstEnv(env, envsToLookup)
+	if err != nil {
+		return true, err
+	}
 
-			return true, err
-		case reflect.Int16:
-			vv, err := strconv.ParseInt(rawEnv, 10, 16)
-			v.SetInt(vv)
+	if found {
+		separator, csv := sliceSplitting(tag)
 
+		rawEntries, err := cli.SplitValues(rawEnv, separator, csv)
+		if err != nil {
 			return true, err
-		case reflect.Int32:
-			vv, err := strconv.ParseInt(rawEnv, 10, 32)
-			v.SetInt(vv)
+		}
 
-			return true, err
-		case reflect.Int64:
-			vv, err := strconv.ParseInt(rawEnv, 10, 64)
-			v.SetInt(vv)
+		for _, rawEntry := range rawEntries {
+			key, value, found := strings.Cut(rawEntry, "=")
+			if !found {
+				return true, fmt.Errorf("invalid map entry %q: expected key=value", rawEntry)
+			}
 
-			return true, err
-		case reflect.Uint:
-			vv, err := strconv.ParseUint(rawEnv, 10, 0)
-			v.SetUint(vv)
+			entries = append(entries, [2]string{strings.TrimSpace(key), strings.TrimSpace(value)})
+		}
+	}
 
-			return true, err
-		case reflect.Uint8:
-			vv, err := strconv.ParseUint(rawEnv, 10, 8)
-			v.SetUint(vv)
+	for _, envToLookup := range envsToLookup {
+		envToLookup = strings.TrimSpace(envToLookup)
+		if envToLookup == "" {
+			continue
+		}
 
-			return true, err
-		case reflect.Uint16:
-			vv, err := strconv.ParseUint(rawEnv, 10, 16)
-			v.SetUint(vv)
+		prefix := SanitizeName(envToLookup) + "_"
 
-			return true, err
-		case reflect.Uint32:
-			vv, err := strconv.ParseUint(rawEnv, 10, 32)
-			v.SetUint(vv)
+		for _, rawEnv := range env.list() {
+			name, value, _ := strings.Cut(rawEnv, "=")
+			if key, isPrefixed := strings.CutPrefix(name, prefix); isPrefixed && key != "" && "_"+key != fileSuffix {
+				entries = append(entries, [2]string{key, value})
+			}
+		}
+	}
 
-			return true, err
-		case reflect.Uint64:
-			vv, err := strconv.ParseUint(rawEnv, 10, 64)
-			v.SetUint(vv)
+	if len(entries) == 0 {
+		return false, nil
+	}
 
-			return true, err
-		case reflect.Float32:
-			vv, err := strconv.ParseFloat(rawEnv, 32)
-			v.SetFloat(vv)
+	var (
+		t    = v.Type()
+		m    = reflect.MakeMapWithSize(t, len(entries))
+		errs []error
+	)
 
-			return true, err
-		case reflect.Float64:
-			vv, err := strconv.ParseFloat(rawEnv, 64)
-			v.SetFloat(vv)
+	for _, entry := range entries {
+		key, value := reflect.New(t.Key()).Elem(), reflect.New(t.Elem()).Elem()
 
-			return true, err
-		case reflect.Complex64:
-			vv, err := strconv.ParseComplex(rawEnv, 64)
-			v.SetComplex(vv)
+		if err := setValueFromString(key, entry[0]); err != nil {
+			errs = append(errs, fmt.Errorf("invalid map key %q: %w", entry[0], err))
+			continue
+		}
 
-			return true, err
-		case reflect.Complex128:
-			vv, err := strconv.ParseComplex(rawEnv, 128)
-			v.SetComplex(vv)
+		if err := setValueFromString(value, entry[1]); err != nil {
+			errs = append(errs, fmt.Errorf("invalid map value %q for key %q: %w", entry[1], entry[0], err))
+			continue
+		}
 
-			return true, err
-		case reflect.String:
-			v.SetString(rawEnv)
+		m.SetMapIndex(key, value)
+	}
 
-			return true, nil
-		default:
-			return true, fmt.Errorf("unhandled type %s", k)
+	v.Set(m)
+
+	return true, errors.Join(errs...)
+}
+
+// setSliceFromString replaces the slice v by the values found in raw,
+// split according to the provided field tag, see sliceSplitting.
+func setSliceFromString(v reflect.Value, raw string, tag reflect.StructTag) error {
+	separator, csv := sliceSplitting(tag)
+
+	rawValues, err := cli.SplitValues(raw, separator, csv)
+	if err != nil {
+		return err
+	}
+
+	values := reflect.MakeSlice(v.Type(), len(rawValues), len(rawValues))
+	for i, rawValue := range rawValues {
+		if err := setValueFromString(values.Index(i), rawValue); err != nil {
+			return fmt.Errorf("invalid value %q: %w", rawValue, err)
 		}
 	}
+
+	v.Set(values)
+
+	return nil
+}
+
+// sliceSplitting returns the way to split slices and maps values from the provided field tag,
+// matching the WithSeparator and WithCSV flag options. The separator is a comma by default,
+// and is set by the `envsep` key ; an empty separator disables the splitting. Values are parsed
+// like a CSV record when the `envcsv` key is "true".
+func sliceSplitting(tag reflect.StructTag) (string, bool) {
+	separator, found := tag.Lookup("envsep")
+	if !found {
+		separator = ","
+	}
+
+	return separator, tag.Get("envcsv") == "true"
+}
+
+// isTextUnmarshaler returns whether the provided value can decode itself using encoding.TextUnmarshaler.
+func isTextUnmarshaler(v reflect.Value) bool {
+	return v.CanAddr() && v.Kind() != reflect.Pointer && reflect.PointerTo(v.Type()).Implements(textUnmarshalerType)
+}
+
+// setValueFromString converts raw to the type of v and sets it. Standard library types
+// are parsed like the corresponding flags, types implementing encoding.TextUnmarshaler decode
+// themselves, and primitive types are parsed with strconv.
+func setValueFromString(v reflect.Value, raw string) error {
+	if parse, isStdlibType := stdlibTypesParsers[v.Type()]; isStdlibType {
+		value, err := parse(raw)
+		if err != nil {
+			return err
+		}
+
+		v.Set(reflect.ValueOf(value))
+
+		return nil
+	}
+
+	if isTextUnmarshaler(v) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw)) //nolint:errcheck,forcetypeassert // checked above
+	}
+
+	switch k := v.Kind(); k {
+	case reflect.Bool:
+		vv, err := strconv.ParseBool(raw)
+		v.SetBool(vv)
+
+		return err
+	case reflect.Int:
+		vv, err := strconv.ParseInt(raw, 10, 0)
+		v.SetInt(vv)
+
+		return err
+	case reflect.Int8:
+		vv, err := strconv.ParseInt(raw, 10, 8)
+		v.SetInt(vv)
+
+		return err
+	case reflect.Int16:
+		vv, err := strconv.ParseInt(raw, 10, 16)
+		v.SetInt(vv)
+
+		return err
+	case reflect.Int32:
+		vv, err := strconv.ParseInt(raw, 10, 32)
+		v.SetInt(vv)
+
+		return err
+	case reflect.Int64:
+		vv, err := strconv.ParseInt(raw, 10, 64)
+		v.SetInt(vv)
+
+		return err
+	case reflect.Uint:
+		vv, err := strconv.ParseUint(raw, 10, 0)
+		v.SetUint(vv)
+
+		return err
+	case reflect.Uint8:
+		vv, err := strconv.ParseUint(raw, 10, 8)
+		v.SetUint(vv)
+
+		return err
+	case reflect.Uint16:
+		vv, err := strconv.ParseUint(raw, 10, 16)
+		v.SetUint(vv)
+
+		return err
+	case reflect.Uint32:
+		vv, err := strconv.ParseUint(raw, 10, 32)
+		v.SetUint(vv)
+
+		return err
+	case reflect.Uint64:
+		vv, err := strconv.ParseUint(raw, 10, 64)
+		v.SetUint(vv)
+
+		return err
+	case reflect.Float32:
+		vv, err := strconv.ParseFloat(raw, 32)
+		v.SetFloat(vv)
+
+		return err
+	case reflect.Float64:
+		vv, err := strconv.ParseFloat(raw, 64)
+		v.SetFloat(vv)
+
+		return err
+	case reflect.Complex64:
+		vv, err := strconv.ParseComplex(raw, 64)
+		v.SetComplex(vv)
+
+		return err
+	case reflect.Complex128:
+		vv, err := strconv.ParseComplex(raw, 128)
+		v.SetComplex(vv)
+
+		return err
+	case reflect.String:
+		v.SetString(raw)
+
+		return nil
+	default:
+		return fmt.Errorf("unhandled type %s", k)
+	}
+}
+
+// lookupFirstEnv returns the value of the first environment variable set among the provided names.
+// When a variable is not set, the variable suffixed by _FILE is looked up, and the value is read
+// from the file it designates. An empty value is considered as not found.
+func lookupFirstEnv(env environment, envsToLookup []string) (string, bool, error) {
+	for _, envToLookup := range envsToLookup {
+		envToLookup = strings.TrimSpace(envToLookup)
+		if envToLookup == "" {
+			continue
+		}
+
+		name := SanitizeName(envToLookup)
+
+		if value, isset := env.lookup(name); isset {
+			return value, value != "", nil
+		}
+
+		if path, isset := env.lookup(name + fileSuffix); isset && path != "" {
+			value, err := env.readFile(path)
+			if err != nil {
+				return "", true, fmt.Errorf("unable to read %s: %w", name+fileSuffix, err)
+			}
+
+			return value, value != "", nil
+		}
+	}
+
+	return "", false, nil
+}
+
+//nolint:gochecknoglobals // read-only type
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// stdlibTypesParsers are the parsers of the standard library types handled like primitive types,
+// decoding environment variables the same way the corresponding flags do.
+//
+//nolint:gochecknoglobals // read-only table
+var stdlibTypesParsers = map[reflect.Type]func(string) (any, error){
+	reflect.TypeFor[time.Duration](): func(raw string) (any, error) { return time.ParseDuration(raw) },
+	reflect.TypeFor[time.Time]():     func(raw string) (any, error) { return time.Parse(time.RFC3339, raw) },
+	reflect.TypeFor[*url.URL]():      func(raw string) (any, error) { return url.Parse(raw) },
+	reflect.TypeFor[net.IP](): func(raw string) (any, error) {
+		ip := net.ParseIP(raw)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address %q", raw)
+		}
+
+		return ip, nil
+	},
+	reflect.TypeFor[netip.Addr]():     func(raw string) (any, error) { return netip.ParseAddr(raw) },
+	reflect.TypeFor[netip.Prefix]():   func(raw string) (any, error) { return netip.ParsePrefix(raw) },
+	reflect.TypeFor[*regexp.Regexp](): func(raw string) (any, error) { return regexp.Compile(raw) },
+	reflect.TypeFor[cli.ByteSize]():   func(raw string) (any, error) { return cli.ParseByteSize(raw) },
 }
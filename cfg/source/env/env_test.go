@@ -1,12 +1,22 @@
 package sourceenv
 
 import (
+	"log/slog"
+	"net"
+	"net/netip"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	gocmpopts "github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/krostar/test"
 	"github.com/krostar/test/check"
+
+	"github.com/krostar/cli"
 )
 
 type configWithEnv struct {
@@ -210,12 +220,239 @@ func Test_Source(t *testing.T) {
 		}, gocmpopts.IgnoreUnexported(configWithEmbedded{}, foo1{}, foo2{})))
 	})
 
+	t.Run("standard library types", func(t *testing.T) {
+		for key, value := range map[string]string{
+			"CUSTOMTESTENV_DURATION": "1h30m",
+			"CUSTOMTESTENV_TIME":     "2024-01-02T03:04:05Z",
+			"CUSTOMTESTENV_URL":      "https://example.com/path",
+			"CUSTOMTESTENV_IP":       "192.168.0.1",
+			"CUSTOMTESTENV_ADDR":     "::1",
+			"CUSTOMTESTENV_PREFIX":   "10.0.0.0/8",
+			"CUSTOMTESTENV_REGEXP":   "^a+$",
+			"CUSTOMTESTENV_SIZE":     "10MiB",
+			"CUSTOMTESTENV_PSIZE":    "1kB",
+		} {
+			t.Setenv(key, value)
+		}
+
+		type configWithStdlibTypes struct {
+			Duration time.Duration
+			Time     time.Time
+			URL      *url.URL
+			IP       net.IP
+			Addr     netip.Addr
+			Prefix   netip.Prefix
+			Regexp   *regexp.Regexp
+			Size     cli.ByteSize
+			PSize    *cli.ByteSize
+			NotSet   *url.URL
+		}
+
+		var cfg configWithStdlibTypes
+
+		err := Source[configWithStdlibTypes]("CUSTOMTESTENV")(test.Context(t), &cfg)
+		test.Require(t, err == nil, err)
+
+		test.Assert(t, cfg.Duration == 90*time.Minute)
+		test.Assert(t, cfg.Time.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+		test.Assert(t, cfg.URL != nil && cfg.URL.Host == "example.com" && cfg.URL.Path == "/path")
+		test.Assert(t, cfg.IP.Equal(net.IPv4(192, 168, 0, 1)))
+		test.Assert(t, cfg.Addr == netip.IPv6Loopback())
+		test.Assert(t, cfg.Prefix == netip.MustParsePrefix("10.0.0.0/8"))
+		test.Assert(t, cfg.Regexp != nil && cfg.Regexp.MatchString("aaa") && !cfg.Regexp.MatchString("b"))
+		test.Assert(t, cfg.Size == 10<<20)
+		test.Assert(t, cfg.PSize != nil && *cfg.PSize == 1000)
+		test.Assert(t, cfg.NotSet == nil)
+	})
+
+	t.Run("invalid standard library type", func(t *testing.T) {
+		t.Setenv("CUSTOMTESTENV_DURATION", "forever")
+
+		type configWithDuration struct{ Duration time.Duration }
+
+		err := Source[configWithDuration]("CUSTOMTESTENV")(test.Context(t), new(configWithDuration))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "time: invalid duration"))
+	})
+
+	t.Run("text unmarshalers", func(t *testing.T) {
+		t.Setenv("CUSTOMTESTENV_LEVEL", "warn")
+		t.Setenv("CUSTOMTESTENV_PLEVEL", "debug")
+		t.Setenv("CUSTOMTESTENV_NESTED_LEVEL", "error")
+
+		type configWithTextUnmarshalers struct {
+			Level  slog.Level
+			PLevel *slog.Level
+			NotSet *slog.Level
+			Nested struct{ Level slog.Level }
+		}
+
+		var cfg configWithTextUnmarshalers
+
+		err := Source[configWithTextUnmarshalers]("CUSTOMTESTENV")(test.Context(t), &cfg)
+		test.Require(t, err == nil, err)
+		test.Assert(t, cfg.Level == slog.LevelWarn)
+		test.Assert(t, cfg.PLevel != nil && *cfg.PLevel == slog.LevelDebug)
+		test.Assert(t, cfg.NotSet == nil)
+		test.Assert(t, cfg.Nested.Level == slog.LevelError)
+	})
+
+	t.Run("invalid text", func(t *testing.T) {
+		t.Setenv("CUSTOMTESTENV_LEVEL", "verbose")
+
+		type configWithLevel struct{ Level slog.Level }
+
+		err := Source[configWithLevel]("CUSTOMTESTENV")(test.Context(t), new(configWithLevel))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "unknown name"))
+	})
+
+	t.Run("slices", func(t *testing.T) {
+		t.Setenv("CUSTOMTESTENV_TAGS", "a, b")
+		t.Setenv("CUSTOMTESTENV_PORTS", "80;443")
+		t.Setenv("CUSTOMTESTENV_NAMES", `"doe, john",jane`)
+		t.Setenv("CUSTOMTESTENV_RAW", "a,b")
+		t.Setenv("CUSTOMTESTENV_TIMEOUTS", "1s,1m")
+
+		type configWithSlices struct {
+			Tags     []string
+			Ports    []int    `envsep:";"`
+			Names    []string `envcsv:"true"`
+			Raw      []string `envsep:""`
+			Timeouts []time.Duration
+			NotSet   []string
+		}
+
+		cfg := configWithSlices{Tags: []string{"default"}, NotSet: []string{"default"}}
+
+		err := Source[configWithSlices]("CUSTOMTESTENV")(test.Context(t), &cfg)
+		test.Require(t, err == nil, err)
+		test.Assert(check.Compare(t, cfg, configWithSlices{
+			Tags:     []string{"a", "b"},
+			Ports:    []int{80, 443},
+			Names:    []string{"doe, john", "jane"},
+			Raw:      []string{"a,b"},
+			Timeouts: []time.Duration{time.Second, time.Minute},
+			NotSet:   []string{"default"},
+		}))
+	})
+
+	t.Run("invalid slices", func(t *testing.T) {
+		t.Setenv("CUSTOMTESTENV_PORTS", "80,http")
+
+		type configWithSlice struct{ Ports []int }
+
+		err := Source[configWithSlice]("CUSTOMTESTENV")(test.Context(t), new(configWithSlice))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), `invalid value "http"`), err)
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		t.Run("encoded variable", func(t *testing.T) {
+			t.Setenv("CUSTOMTESTENV_E", "k1=v1, k2 = v2")
+
+			cfg := configWithEnv{E: map[string]string{"default": "value"}}
+
+			err := Source[configWithEnv]("CUSTOMTESTENV")(test.Context(t), &cfg)
+			test.Require(t, err == nil, err)
+			test.Assert(check.Compare(t, cfg.E, map[string]string{"k1": "v1", "k2": "v2"}))
+		})
+
+		t.Run("family of prefixed variables", func(t *testing.T) {
+			t.Setenv("CUSTOMTESTENV_E", "k1=v1,K2=v2")
+			t.Setenv("CUSTOMTESTENV_E_K2", "v2 from family")
+			t.Setenv("CUSTOMTESTENV_E_K3", "v3")
+
+			var cfg configWithEnv
+
+			err := Source[configWithEnv]("CUSTOMTESTENV")(test.Context(t), &cfg)
+			test.Require(t, err == nil, err)
+			test.Assert(check.Compare(t, cfg.E, map[string]string{"k1": "v1", "K2": "v2 from family", "K3": "v3"}))
+		})
+
+		t.Run("typed keys and values", func(t *testing.T) {
+			t.Setenv("CUSTOMTESTENV_TIMEOUTS", "1=1s,2=1m")
+
+			type configWithTypedMap struct{ Timeouts map[int]time.Duration }
+
+			var cfg configWithTypedMap
+
+			err := Source[configWithTypedMap]("CUSTOMTESTENV")(test.Context(t), &cfg)
+			test.Require(t, err == nil, err)
+			test.Assert(check.Compare(t, cfg.Timeouts, map[int]time.Duration{1: time.Second, 2: time.Minute}))
+		})
+
+		t.Run("custom separator", func(t *testing.T) {
+			t.Setenv("CUSTOMTESTENV_LABELS", "a=1,2;b=3")
+
+			type configWithMap struct {
+				Labels map[string]string `envsep:";"`
+			}
+
+			var cfg configWithMap
+
+			err := Source[configWithMap]("CUSTOMTESTENV")(test.Context(t), &cfg)
+			test.Require(t, err == nil, err)
+			test.Assert(check.Compare(t, cfg.Labels, map[string]string{"a": "1,2", "b": "3"}))
+		})
+
+		t.Run("invalid entries", func(t *testing.T) {
+			t.Setenv("CUSTOMTESTENV_TIMEOUTS", "a=1s,2=forever")
+
+			type configWithTypedMap struct{ Timeouts map[int]time.Duration }
+
+			err := Source[configWithTypedMap]("CUSTOMTESTENV")(test.Context(t), new(configWithTypedMap))
+			test.Require(t, err != nil)
+			test.Assert(t, strings.Contains(err.Error(), `invalid map key "a"`), err)
+			test.Assert(t, strings.Contains(err.Error(), `invalid map value "forever" for key "2"`), err)
+		})
+	})
+
+	t.Run("values read from files", func(t *testing.T) {
+		dir := t.TempDir()
+		test.Require(t, os.WriteFile(filepath.Join(dir, "password"), []byte("s3cr3t\n"), 0o600) == nil)
+		test.Require(t, os.WriteFile(filepath.Join(dir, "labels"), []byte("a=1,b=2\n"), 0o600) == nil)
+
+		t.Setenv("CUSTOMTESTENV_DB_PASSWORD_FILE", filepath.Join(dir, "password"))
+		t.Setenv("CUSTOMTESTENV_DB_USER", "admin")
+		t.Setenv("CUSTOMTESTENV_DB_USER_FILE", filepath.Join(dir, "missing"))
+		t.Setenv("CUSTOMTESTENV_LABELS_FILE", filepath.Join(dir, "labels"))
+		t.Setenv("CUSTOMTESTENV_LABELS_C", "3")
+
+		type configWithFiles struct {
+			DB struct {
+				User     string
+				Password string
+			}
+			Labels map[string]string
+		}
+
+		var cfg configWithFiles
+
+		err := Source[configWithFiles]("CUSTOMTESTENV")(test.Context(t), &cfg)
+		test.Require(t, err == nil, err)
+		test.Assert(t, cfg.DB.User == "admin" && cfg.DB.Password == "s3cr3t", cfg.DB)
+		test.Assert(check.Compare(t, cfg.Labels, map[string]string{"a": "1", "b": "2", "C": "3"}))
+
+		t.Run("missing file", func(t *testing.T) {
+			t.Setenv("CUSTOMTESTENV_DB_PASSWORD_FILE", filepath.Join(dir, "missing"))
+
+			err := Source[configWithFiles]("CUSTOMTESTENV")(test.Context(t), new(configWithFiles))
+			test.Assert(t, err != nil && strings.Contains(err.Error(), "unable to read CUSTOMTESTENV_DB_PASSWORD_FILE"), err)
+		})
+	})
+
 	t.Run("unhandled type", func(t *testing.T) {
 		t.Setenv("CUSTOMTESTENV_D_D2", "foo")
 		t.Setenv("CUSTOMTESTENV_E", "foo")
+		t.Setenv("CUSTOMTESTENV_F", "foo")
+
+		type configWithUnhandledType struct {
+			configWithEnv `env:"^"`
+
+			F func()
+		}
 
-		err := Source[configWithEnv]("CUSTOMTESTENV")(test.Context(t), new(configWithEnv))
+		err := Source[configWithUnhandledType]("CUSTOMTESTENV")(test.Context(t), new(configWithUnhandledType))
 		test.Assert(t, err != nil && strings.Contains(err.Error(), "strconv.ParseInt"))
-		test.Assert(t, err != nil && strings.Contains(err.Error(), "unhandled type map"))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), `invalid map entry "foo": expected key=value`))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "unhandled type func"))
 	})
 }
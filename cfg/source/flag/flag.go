@@ -12,6 +12,8 @@ import (
 )
 
 // Source returns a SourceFunc that applies command-line flag values to a configuration struct.
+// Arguments bound to destinations (see cli.NewArg) are applied the same way, those bound
+// outside flagDest are ignored.
 //
 // It uses reflection to find fields in the config struct that match the flag destinations
 // and updates them with the flag values. It's designed to work with the context-based flag
@@ -25,6 +27,9 @@ import (
 // from command-line flags. It should typically be the last source in the hook to ensure
 // flag values take precedence over other configuration sources.
 //
+// Required flags not set on the command line are considered provided (see cli.WithRequired) when
+// the previous sources provided a value, that is not the zero value, to the field of their destination.
+//
 // Example:
 //
 //	type Config struct {
@@ -55,11 +60,33 @@ import (
 func Source[T any](flagDest *T) clicfg.SourceFunc[T] {
 	return func(ctx context.Context, cfg *T) error {
 		pointersToValuesSetByFlags := make(map[uintptr]struct{})
+		pointersToValuesSetByArgs := make(map[uintptr]struct{})
 		{
+			pointersToValuesOfUnsetFlags := make(map[uintptr]any)
+
 			localFlags, persistentFlags := cli.GetInitializedFlagsFromContext(ctx)
 			for _, flag := range append(localFlags, persistentFlags...) {
 				if flag.IsSet() {
 					pointersToValuesSetByFlags[uintptr(reflect.ValueOf(flag.Destination()).UnsafePointer())] = struct{}{}
+				} else if dest := reflect.ValueOf(flag.Destination()); dest.Kind() == reflect.Pointer && !dest.IsNil() {
+					pointersToValuesOfUnsetFlags[uintptr(dest.UnsafePointer())] = flag.Destination()
+				}
+			}
+
+			// values provided by the previous sources satisfy the requirements of the flags not set
+			cli.SetConfigProvidedInContext(ctx, findValuesProvidedByConfig(pointersToValuesOfUnsetFlags, reflect.ValueOf(flagDest).Elem(), reflect.ValueOf(cfg).Elem())...)
+
+			// arguments bound to destinations are handled like flags,
+			// but unlike flags they may be bound outside the configuration
+			for _, arg := range cli.GetInitializedArgsFromContext(ctx) {
+				if !arg.IsSet() {
+					continue
+				}
+
+				ptr := uintptr(reflect.ValueOf(arg.Destination()).UnsafePointer())
+				if _, setByFlag := pointersToValuesSetByFlags[ptr]; !setByFlag {
+					pointersToValuesSetByFlags[ptr] = struct{}{}
+					pointersToValuesSetByArgs[ptr] = struct{}{}
 				}
 			}
 
@@ -72,8 +99,12 @@ func Source[T any](flagDest *T) clicfg.SourceFunc[T] {
 			return fmt.Errorf("unable to walk through config: %v", err)
 		}
 
+		for ptr := range pointersToValuesSetByArgs {
+			delete(pointersToValuesSetByFlags, ptr)
+		}
+
 		if len(pointersToValuesSetByFlags) != 0 {
-			return errors.New("some values where not found, make sure flag values all points to config")
+			return errors.New("some values were not found, make sure flag values all points to config")
 		}
 
 		return nil
@@ -161,6 +192,50 @@ func recursivelyWalkThroughReflectValue(pointers map[uintptr]struct{}, v1, v2 re
 	}
 }
 
+// findValuesProvidedByConfig recursively traverses two reflect.Values (v1 and v2) like recursivelyWalkThroughReflectValue,
+// and returns the destinations of the pointers map whose corresponding value in v2 is not the zero value.
+//
+// Parameters:
+//   - pointers: A map of memory addresses (as uintptr) of the values of the flag destination struct, to the destinations.
+//   - v1: The reflect.Value of the flag destination struct
+//   - v2: The reflect.Value of the config struct holding the values provided by the configuration
+func findValuesProvidedByConfig(pointers map[uintptr]any, v1, v2 reflect.Value) []any {
+	if len(pointers) == 0 {
+		return nil
+	}
+
+	if v1.CanAddr() {
+		// the first field of a struct shares the address of the struct
+		if dest, ok := pointers[uintptr(v1.Addr().UnsafePointer())]; ok && reflect.TypeOf(dest).Elem() == v1.Type() {
+			if v2.IsZero() {
+				return nil
+			}
+
+			return []any{dest}
+		}
+	}
+
+	switch v1.Type().Kind() {
+	case reflect.Pointer:
+		if v1.IsNil() || v2.IsNil() {
+			return nil
+		}
+
+		return findValuesProvidedByConfig(pointers, v1.Elem(), v2.Elem())
+
+	case reflect.Struct:
+		var provided []any
+		for i := range v1.NumField() {
+			provided = append(provided, findValuesProvidedByConfig(pointers, v1.Field(i), v2.Field(i))...)
+		}
+
+		return provided
+
+	default:
+		return nil
+	}
+}
+
 func ensurePointerInitialized(v reflect.Value) (reflect.Value, func() error) {
 	if !v.IsNil() {
 		return v, func() error { return nil }
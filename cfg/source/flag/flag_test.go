@@ -132,6 +132,86 @@ func Test_Source(t *testing.T) {
 		test.Assert(t, cfg.Database.DSN == "postgres://localhost:5432/db", "Expected Database.DSN to be postgres://localhost:5432/db")
 	})
 
+	t.Run("arguments", func(t *testing.T) {
+		type argsConfig struct {
+			Host  string
+			Port  int
+			Names []string
+			Debug bool
+		}
+
+		var cfgForFlags argsConfig
+
+		ctx := cli.NewCommandContext(test.Context(t))
+		cli.SetInitializedFlagsInContext(ctx, []cli.Flag{cli.NewBuiltinFlag("debug", "", &cfgForFlags.Debug, "")}, nil)
+		cli.SetInitializedArgsInContext(ctx, &cli.ArgsSpec{Positional: &cli.ArgList{
+			Args:     []cli.Arg{cli.NewArg("host", &cfgForFlags.Host, ""), cli.NewArg("port", &cfgForFlags.Port, "")},
+			Optional: 1,
+			Variadic: cli.NewVariadicArg("names", &cfgForFlags.Names, ""),
+		}})
+
+		args := cli.GetInitializedArgsFromContext(ctx)
+		test.Require(t, len(args) == 3)
+		test.Assert(t, args[0].FromString("localhost") == nil)
+		test.Assert(t, args[2].FromString("a") == nil)
+		test.Assert(t, args[2].FromString("b") == nil)
+
+		cfg := &argsConfig{Port: 8080}
+		test.Require(t, Source(&cfgForFlags)(ctx, cfg) == nil)
+		test.Assert(check.Compare(t, cfg, &argsConfig{Host: "localhost", Port: 8080, Names: []string{"a", "b"}}))
+	})
+
+	t.Run("arguments bound outside the config", func(t *testing.T) {
+		type argsConfig struct {
+			Host  string
+			Debug bool
+		}
+
+		var (
+			cfgForFlags argsConfig
+			outside     string
+		)
+
+		ctx := cli.NewCommandContext(test.Context(t))
+		cli.SetInitializedFlagsInContext(ctx, []cli.Flag{cli.NewBuiltinFlag("debug", "", &cfgForFlags.Debug, "")}, nil)
+		cli.SetInitializedArgsInContext(ctx, &cli.ArgsSpec{Positional: &cli.ArgList{
+			Args: []cli.Arg{cli.NewArg("host", &cfgForFlags.Host, ""), cli.NewArg("outside", &outside, "")},
+		}})
+
+		flags, _ := cli.GetInitializedFlagsFromContext(ctx)
+		args := cli.GetInitializedArgsFromContext(ctx)
+		test.Require(t, len(flags) == 1 && len(args) == 2)
+		test.Assert(t, flags[0].FromString("true") == nil)
+		test.Assert(t, args[0].FromString("localhost") == nil)
+		test.Assert(t, args[1].FromString("value") == nil)
+
+		cfg := new(argsConfig)
+		test.Require(t, Source(&cfgForFlags)(ctx, cfg) == nil)
+		test.Assert(check.Compare(t, cfg, &argsConfig{Host: "localhost", Debug: true}))
+		test.Assert(t, outside == "value")
+	})
+
+	t.Run("counters", func(t *testing.T) {
+		type verbosityConfig struct {
+			Verbosity int
+			Name      string
+		}
+
+		var cfgForFlags verbosityConfig
+
+		ctx := cli.NewCommandContext(test.Context(t))
+		cli.SetInitializedFlagsInContext(ctx, []cli.Flag{cli.NewCounterFlag("verbose", "v", &cfgForFlags.Verbosity, "")}, nil)
+
+		flagsLocal, _ := cli.GetInitializedFlagsFromContext(ctx)
+		test.Require(t, len(flagsLocal) == 1)
+		test.Assert(t, flagsLocal[0].FromString(cli.FlagCounterIncrement) == nil)
+		test.Assert(t, flagsLocal[0].FromString(cli.FlagCounterIncrement) == nil)
+
+		cfg := &verbosityConfig{Verbosity: 1, Name: "fromfile"}
+		test.Require(t, Source(&cfgForFlags)(ctx, cfg) == nil)
+		test.Assert(check.Compare(t, cfg, &verbosityConfig{Verbosity: 2, Name: "fromfile"}))
+	})
+
 	t.Run("no flags in command", func(t *testing.T) {
 		var cfgForFlags configWithFlag
 
@@ -154,6 +234,37 @@ func Test_Source(t *testing.T) {
 		test.Assert(t, src(ctx, new(configWithFlag)) == nil)
 	})
 
+	t.Run("values provided by the configuration", func(t *testing.T) {
+		var cfgForFlags configWithFlag
+
+		ctx := cli.NewCommandContext(test.Context(t))
+		cli.SetInitializedFlagsInContext(ctx,
+			[]cli.Flag{
+				cli.NewBuiltinFlag("a", "", &cfgForFlags.A, ""),
+				cli.NewBuiltinPointerFlag("b", "", &cfgForFlags.B, ""),
+				cli.NewBuiltinFlag("e-a", "", new(string), ""),
+			},
+			[]cli.Flag{
+				cli.NewBuiltinPointerFlag("d-a", "", &cfgForFlags.D.A, ""),
+				cli.NewBuiltinFlag("d-b", "", &cfgForFlags.D.B, ""),
+			},
+		)
+
+		flagsLocal, _ := cli.GetInitializedFlagsFromContext(ctx)
+		test.Require(t, flagsLocal[0].FromString("str") == nil)
+
+		cfg := &configWithFlag{B: ptrTo(0), D: struct {
+			A *string
+			B int
+		}{B: 42}}
+		test.Require(t, Source(&cfgForFlags)(ctx, cfg) == nil)
+
+		test.Assert(t, !cli.IsConfigProvidedInContext(ctx, &cfgForFlags.A), "set on the command line")
+		test.Assert(t, cli.IsConfigProvidedInContext(ctx, &cfgForFlags.B), "non-nil pointer")
+		test.Assert(t, !cli.IsConfigProvidedInContext(ctx, &cfgForFlags.D.A), "zero value")
+		test.Assert(t, cli.IsConfigProvidedInContext(ctx, &cfgForFlags.D.B), "nested value")
+	})
+
 	t.Run("dest is not part of config", func(t *testing.T) {
 		var (
 			cfgForFlags configWithFlag
@@ -172,7 +283,7 @@ func Test_Source(t *testing.T) {
 		test.Assert(t, flagsPersistent[0].FromString("42") == nil)
 
 		err := src(ctx, new(configWithFlag))
-		test.Assert(t, err != nil && strings.Contains(err.Error(), "some values where not found, make sure flag values all points to config"))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "some values were not found, make sure flag values all points to config"))
 	})
 
 	t.Run("handling embedding results in cfg", func(t *testing.T) {
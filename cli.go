@@ -57,6 +57,40 @@ type CLI struct {
 	SubCommands []*CLI
 }
 
+// SubCommandOption defines options to customize subcommands added with AddCommand or Mount.
+type SubCommandOption func(*CLI)
+
+// WithHiddenCommand hides the subcommand from the help of its parent, while keeping it usable.
+// The command is wrapped to implement CommandHidden, which is useful to hide a command
+// that is also available under another name.
+func WithHiddenCommand() SubCommandOption {
+	return func(c *CLI) { c.Command = hiddenCommand{Command: c.Command} }
+}
+
+// WithDeprecatedCommand marks the subcommand as deprecated: it is hidden from the help of its
+// parent, and the provided message, like `use "new" instead`, is displayed as a warning when
+// the subcommand is used. The command is wrapped to implement CommandDeprecated, which is useful
+// to deprecate the old name of a renamed command.
+func WithDeprecatedCommand(message string) SubCommandOption {
+	return func(c *CLI) { c.Command = deprecatedCommand{Command: c.Command, deprecation: message} }
+}
+
+// hiddenCommand implements CommandHidden for the wrapped command, see WithHiddenCommand.
+type hiddenCommand struct{ Command }
+
+func (hiddenCommand) Hidden() bool         { return true }
+func (c hiddenCommand) As(target any) bool { return asWrappedCommand(c.Command, target) }
+
+// deprecatedCommand implements CommandDeprecated for the wrapped command, see WithDeprecatedCommand.
+type deprecatedCommand struct {
+	Command
+
+	deprecation string
+}
+
+func (c deprecatedCommand) Deprecation() string { return c.deprecation }
+func (c deprecatedCommand) As(target any) bool  { return asWrappedCommand(c.Command, target) }
+
 // New creates a new CLI with the given command as its root.
 // This is the entry point for building a CLI application.
 //
@@ -69,15 +103,23 @@ func New(cmd Command) *CLI {
 
 // AddCommand adds a subcommand to the CLI with the given name.
 // The name will be used to invoke the command on the command line.
+// Options can hide or deprecate the subcommand.
 // Returns the CLI instance for chaining method calls.
 //
 // Example:
 //
 //	rootCmd := New(myRootCommand{}).
 //	    AddCommand("serve", &serveCommand{}).
-//	    AddCommand("version", &versionCommand{})
-func (cli *CLI) AddCommand(name string, cmd Command) *CLI {
-	cli.SubCommands = append(cli.SubCommands, &CLI{Name: name, Command: cmd})
+//	    AddCommand("version", &versionCommand{}).
+//	    AddCommand("start", &serveCommand{}, WithDeprecatedCommand(`use "serve" instead`))
+func (cli *CLI) AddCommand(name string, cmd Command, opts ...SubCommandOption) *CLI {
+	sub := &CLI{Name: name, Command: cmd}
+	for _, opt := range opts {
+		opt(sub)
+	}
+
+	cli.SubCommands = append(cli.SubCommands, sub)
+
 	return cli
 }
 
@@ -86,7 +128,8 @@ func (cli *CLI) AddCommand(name string, cmd Command) *CLI {
 // Unlike AddCommand which adds a single command, Mount adds an entire
 // command tree with its own subcommands.
 //
-// The name parameter sets the name of the mounted CLI's root command.
+// The name parameter sets the name of the mounted CLI's root command,
+// and options can hide or deprecate it.
 // Returns the current CLI instance for chaining method calls.
 //
 // Example:
@@ -99,9 +142,14 @@ func (cli *CLI) AddCommand(name string, cmd Command) *CLI {
 //	// Mount it to the main CLI
 //	mainCLI := New(mainRootCommand{}).
 //	    Mount("user", userCLI)
-func (cli *CLI) Mount(name string, sub *CLI) *CLI {
+func (cli *CLI) Mount(name string, sub *CLI, opts ...SubCommandOption) *CLI {
 	mount := *sub
 	mount.Name = name
+
+	for _, opt := range opts {
+		opt(&mount)
+	}
+
 	cli.SubCommands = append(cli.SubCommands, &mount)
 
 	return cli
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	gocmp "github.com/google/go-cmp/cmp"
 	"github.com/krostar/test"
 	"github.com/krostar/test/check"
 )
@@ -18,9 +19,9 @@ func Test_CLI(t *testing.T) {
 
 	cli := New(cmd0).
 		AddCommand("cmd1", cmd1).
-		AddCommand("cmd2", cmd2).
+		AddCommand("cmd2", cmd2, WithHiddenCommand()).
 		Mount("cmd3", New(cmd3).AddCommand("cmd31", cmd31)).
-		Mount("cmd4", New(cmd4))
+		Mount("cmd4", New(cmd4), WithDeprecatedCommand("use cmd3 instead"))
 
 	test.Assert(check.Compare(t, cli, &CLI{
 		Command: cmd0,
@@ -31,7 +32,7 @@ func Test_CLI(t *testing.T) {
 			},
 			{
 				Name:    "cmd2",
-				Command: cmd2,
+				Command: hiddenCommand{Command: cmd2},
 			},
 			{
 				Name:    "cmd3",
@@ -45,10 +46,10 @@ func Test_CLI(t *testing.T) {
 			},
 			{
 				Name:    "cmd4",
-				Command: cmd4,
+				Command: deprecatedCommand{Command: cmd4, deprecation: "use cmd3 instead"},
 			},
 		},
-	}))
+	}, gocmp.AllowUnexported(deprecatedCommand{})))
 }
 
 type command0 struct{}
@@ -1,6 +1,9 @@
 package cli
 
-import "context"
+import (
+	"context"
+	"reflect"
+)
 
 type (
 	// Command is the fundamental interface for all CLI commands.
@@ -8,6 +11,13 @@ type (
 		Execute(ctx context.Context, args, dashedArgs []string) error
 	}
 
+	// CommandWrapper can be implemented by commands wrapping another command, like test doubles,
+	// to provide the optional command interfaces (like CommandDescription) they do not implement
+	// directly. Like errors.As, As sets target, a pointer to one of these interfaces, and reports
+	// whether the interface is provided. Mappers must look optional interfaces up with AsCommand,
+	// type assertions do not see the interfaces provided through CommandWrapper.
+	CommandWrapper interface{ As(target any) bool }
+
 	// CommandContext allows commands to customize the context passed to
 	// their subcommands. This is useful for propagating configuration,
 	// dependencies, or other context-specific data down the command tree.
@@ -15,6 +25,20 @@ type (
 		Context(ctx context.Context) context.Context
 	}
 
+	// CommandAliases allows a command to be invoked by alternative names,
+	// in addition to the name it was registered with.
+	CommandAliases interface{ Aliases() []string }
+
+	// CommandHidden allows a command to be hidden from the help of its parent,
+	// while remaining usable.
+	CommandHidden interface{ Hidden() bool }
+
+	// CommandDeprecated allows a command being phased out to be marked as deprecated. The returned
+	// message, usually pointing to a replacement, is displayed as a warning when the command is used.
+	// Deprecated commands are hidden from the help of their parent. An empty message means the command
+	// is not deprecated.
+	CommandDeprecated interface{ Deprecation() string }
+
 	// CommandDescription allows a command to provide a human-readable
 	// description of its purpose. This is used for generating help text.
 	// A short description is created from the first description line.
@@ -29,6 +53,20 @@ type (
 	// This helps define how arguments should be passed to the command.
 	CommandUsage interface{ Usage() string }
 
+	// CommandArgs allows a command to declare the arguments it accepts.
+	// Arguments are parsed and validated before the command execution,
+	// and are used to generate the usage when CommandUsage is not implemented.
+	CommandArgs interface{ Args() *ArgsSpec }
+
+	// CommandCompletion allows a command to complete its arguments in shells.
+	// args are the arguments already provided, and toComplete is the beginning of the
+	// argument being completed ; candidates not starting with toComplete are ignored.
+	// Subcommands names are completed by mappers. Flags may not be parsed when
+	// Complete is called, their values should not be relied upon.
+	CommandCompletion interface {
+		Complete(ctx context.Context, args []string, toComplete string) ([]string, error)
+	}
+
 	// CommandFlags allows a command to define command-line flags.
 	CommandFlags interface{ Flags() []Flag }
 
@@ -71,3 +109,39 @@ type (
 		AfterCommandExecution HookFunc
 	}
 )
+
+// AsCommand returns the implementation of the optional command interface T (like CommandDescription)
+// by the provided command, either implemented directly or provided through CommandWrapper.
+func AsCommand[T any](cmd Command) (T, bool) {
+	if t, ok := cmd.(T); ok {
+		return t, true
+	}
+
+	var t T
+
+	if wrapper, ok := cmd.(CommandWrapper); ok && wrapper.As(&t) {
+		return t, true
+	}
+
+	return t, false
+}
+
+// asWrappedCommand implements CommandWrapper.As for commands wrapping cmd: target is set
+// if cmd implements the interface it points to, directly or through CommandWrapper.
+func asWrappedCommand(cmd Command, target any) bool {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return false
+	}
+
+	if implementation := reflect.ValueOf(cmd); implementation.IsValid() && implementation.Type().AssignableTo(value.Elem().Type()) {
+		value.Elem().Set(implementation)
+		return true
+	}
+
+	if wrapper, ok := cmd.(CommandWrapper); ok {
+		return wrapper.As(target)
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krostar/test"
+)
+
+func Test_AsCommand(t *testing.T) {
+	t.Run("implemented directly", func(t *testing.T) {
+		get, ok := AsCommand[CommandDescription](describedCommand{})
+		test.Assert(t, ok && get.Description() == "described")
+
+		_, ok = AsCommand[CommandUsage](describedCommand{})
+		test.Assert(t, !ok)
+	})
+
+	t.Run("provided by wrappers", func(t *testing.T) {
+		get, ok := AsCommand[CommandDescription](wrappingCommand{})
+		test.Assert(t, ok && get.Description() == "described")
+
+		_, ok = AsCommand[CommandUsage](wrappingCommand{})
+		test.Assert(t, !ok)
+	})
+
+	t.Run("provided by subcommand options", func(t *testing.T) {
+		c := New(nil).
+			AddCommand("hidden", describedCommand{}, WithHiddenCommand()).
+			AddCommand("old", wrappingCommand{}, WithHiddenCommand(), WithDeprecatedCommand("use new instead"))
+
+		hidden, ok := AsCommand[CommandHidden](c.SubCommands[0].Command)
+		test.Assert(t, ok && hidden.Hidden())
+
+		description, ok := AsCommand[CommandDescription](c.SubCommands[0].Command)
+		test.Assert(t, ok && description.Description() == "described")
+
+		_, ok = AsCommand[CommandDeprecated](c.SubCommands[0].Command)
+		test.Assert(t, !ok)
+
+		deprecated, ok := AsCommand[CommandDeprecated](c.SubCommands[1].Command)
+		test.Assert(t, ok && deprecated.Deprecation() == "use new instead")
+
+		hidden, ok = AsCommand[CommandHidden](c.SubCommands[1].Command)
+		test.Assert(t, ok && hidden.Hidden())
+
+		description, ok = AsCommand[CommandDescription](c.SubCommands[1].Command)
+		test.Assert(t, ok && description.Description() == "described")
+
+		_, ok = AsCommand[CommandUsage](c.SubCommands[1].Command)
+		test.Assert(t, !ok)
+	})
+}
+
+type describedCommand struct{}
+
+func (describedCommand) Execute(context.Context, []string, []string) error { return nil }
+
+func (describedCommand) Description() string { return "described" }
+
+type wrappingCommand struct{}
+
+func (wrappingCommand) Execute(context.Context, []string, []string) error { return nil }
+
+func (wrappingCommand) As(target any) bool {
+	if description, ok := target.(*CommandDescription); ok {
+		*description = describedCommand{}
+		return true
+	}
+
+	return false
+}
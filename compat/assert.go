@@ -0,0 +1,79 @@
+package clicompat
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+	clischema "github.com/krostar/cli/schema"
+)
+
+// AssertCompatible compares the provided CLI to the schema snapshot found at the provided path, and fails
+// the test if it breaks its users, see Compare.
+//
+// The snapshot is created if it does not exist, it should then be checked in version control. It is not
+// updated by compatible changes: to describe new commands or flags, or to accept breaking changes like
+// before a major release, remove it and run the test again.
+func AssertCompatible(t test.TestingT, c *cli.CLI, snapshotPath string) {
+	t.Helper()
+
+	changes, created, err := compareToSnapshot(t.Context(), c, snapshotPath)
+	test.Require(t, err == nil, "unable to compare cli to snapshot %s: %v", snapshotPath, err)
+
+	if created {
+		t.Logf("snapshot %s created", snapshotPath)
+	}
+
+	descriptions := make([]string, len(changes))
+	for i, change := range changes {
+		descriptions[i] = change.String()
+	}
+
+	test.Assert(t, len(changes) == 0, "cli is not compatible with snapshot %s:\n%s", snapshotPath, strings.Join(descriptions, "\n"))
+}
+
+// compareToSnapshot returns the breaking changes of the CLI compared to the snapshot, which is created if it does not exist.
+func compareToSnapshot(ctx context.Context, c *cli.CLI, snapshotPath string) ([]Change, bool, error) {
+	var raw bytes.Buffer
+
+	if err := clischema.Write(ctx, &raw, c); err != nil {
+		return nil, false, err
+	}
+
+	snapshot, err := os.ReadFile(snapshotPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err := os.MkdirAll(filepath.Dir(snapshotPath), 0o755); err != nil { //nolint:gosec // snapshots are meant to be checked in version control
+			return nil, false, fmt.Errorf("unable to create directory: %w", err)
+		}
+
+		if err := os.WriteFile(snapshotPath, raw.Bytes(), 0o644); err != nil { //nolint:gosec // snapshots are meant to be checked in version control
+			return nil, false, fmt.Errorf("unable to create snapshot: %w", err)
+		}
+
+		return nil, true, nil
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to read snapshot: %w", err)
+	}
+
+	previous, err := clischema.Read(bytes.NewReader(snapshot))
+	if err != nil {
+		return nil, false, err
+	}
+
+	current, err := clischema.Read(&raw)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return Compare(previous, current), false, nil
+}
@@ -0,0 +1,53 @@
+package clicompat
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/krostar/test"
+	testdouble "github.com/krostar/test/double"
+
+	"github.com/krostar/cli/double"
+	"github.com/krostar/cli/internal/clitest"
+)
+
+func Test_AssertCompatible(t *testing.T) {
+	snapshotPath := filepath.Join(t.TempDir(), "testdata", "schema.json")
+
+	t.Run("snapshot created", func(t *testing.T) {
+		spiedT := testdouble.NewSpy(testdouble.NewFake())
+		AssertCompatible(spiedT, clitest.NewCLI(), snapshotPath)
+		spiedT.ExpectTestToPass(t)
+		spiedT.ExpectLogsToContain(t, "snapshot "+snapshotPath+" created")
+
+		_, err := os.Stat(snapshotPath)
+		test.Assert(t, err == nil, err)
+	})
+
+	t.Run("compatible", func(t *testing.T) {
+		spiedT := testdouble.NewSpy(testdouble.NewFake())
+		AssertCompatible(spiedT, clitest.NewCLI().AddCommand("new", double.NewFake()), snapshotPath)
+		spiedT.ExpectTestToPass(t)
+		spiedT.ExpectNoLogs(t)
+	})
+
+	t.Run("breaking changes", func(t *testing.T) {
+		spiedT := testdouble.NewSpy(testdouble.NewFake())
+		c := clitest.NewCLI()
+		c.SubCommands = c.SubCommands[1:]
+
+		AssertCompatible(spiedT, c, snapshotPath)
+		spiedT.ExpectTestToFail(t)
+		spiedT.ExpectLogsToContain(t, "cli is not compatible with snapshot "+snapshotPath+":\nserve: command removed")
+	})
+
+	t.Run("invalid snapshot", func(t *testing.T) {
+		test.Require(t, os.WriteFile(snapshotPath, []byte(`{"version":42}`), 0o600) == nil)
+
+		spiedT := testdouble.NewSpy(testdouble.NewFake())
+		AssertCompatible(spiedT, clitest.NewCLI(), snapshotPath)
+		spiedT.ExpectTestToFail(t)
+		spiedT.ExpectLogsToContain(t, "unable to compare cli to snapshot "+snapshotPath+": unsupported schema version 42, expected 1")
+	})
+}
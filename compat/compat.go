@@ -0,0 +1,273 @@
+// Package clicompat detects the changes of the surface of a CLI that break its users, like scripts calling it.
+//
+// Surfaces are described by schemas, see the schema package. Compare reports the breaking changes between
+// two schemas, and AssertCompatible compares the CLI to a snapshot checked in version control, so that a
+// refactor can't silently break the users of the CLI:
+//
+//	func Test_CLI(t *testing.T) {
+//		clicompat.AssertCompatible(t, newCLI(), "testdata/schema.json")
+//	}
+//
+// Commands, flags and aliases are matched by name: renaming a command or a flag is compatible as long as
+// the previous name is kept as an alias. Flags moved to a parent command as persistent flags are compatible.
+// Other changes, like new commands, new optional flags or changed descriptions, are compatible.
+package clicompat
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	clischema "github.com/krostar/cli/schema"
+)
+
+// ChangeKind defines the kind of a breaking change.
+type ChangeKind uint8
+
+const (
+	// CommandRemoved is reported for commands that can't be executed anymore.
+	CommandRemoved ChangeKind = iota + 1
+	// CommandAliasRemoved is reported for aliases of commands that have been removed.
+	CommandAliasRemoved
+	// FlagRemoved is reported for flags that can't be set anymore.
+	FlagRemoved
+	// FlagTypeChanged is reported for flags whose type changed.
+	FlagTypeChanged
+	// FlagShortNameChanged is reported for short names of flags that have been removed or assigned to another flag.
+	FlagShortNameChanged
+	// FlagAliasRemoved is reported for aliases of flags that have been removed.
+	FlagAliasRemoved
+	// FlagRequired is reported for optional flags that became required.
+	FlagRequired
+	// ArgsRequired is reported for commands requiring more positional or dashed arguments.
+	ArgsRequired
+	// ArgsRestricted is reported for commands accepting less positional or dashed arguments.
+	ArgsRestricted
+)
+
+// Change is a breaking change.
+type Change struct {
+	Kind ChangeKind
+	// Command is the full name of the command affected by the change, like "app serve".
+	// It does not include the name of the root command if the schemas do not define it.
+	Command string
+	// Message describes the change.
+	Message string
+}
+
+// String implements fmt.Stringer.
+func (change Change) String() string {
+	if change.Command == "" {
+		return change.Message
+	}
+
+	return change.Command + ": " + change.Message
+}
+
+// Compare returns the breaking changes of the current schema compared to the previous one, if any.
+// Changes are ordered like the commands and flags of the previous schema.
+// A missing schema, or a schema without command, describes a CLI without commands: everything
+// is added if the previous schema is missing, and the root command is removed if the current one is.
+func Compare(previous, current *clischema.Schema) []Change {
+	if previous == nil || previous.Command == nil {
+		return nil
+	}
+
+	var changes []Change
+
+	var currentCommand *clischema.Command
+	if current != nil {
+		currentCommand = current.Command
+	}
+
+	compareCommand(&changes, nil, previous.Command, currentCommand, nil, nil)
+
+	return changes
+}
+
+// compareCommand compares the previous command to the current one, which is nil if the command has been removed.
+// The persistent flags of the parents of both commands are provided.
+func compareCommand(changes *[]Change, parents []string, previous, current *clischema.Command, previousInherited, currentInherited []clischema.Flag) {
+	path := append(slices.Clone(parents), previous.Name)
+	report := func(kind ChangeKind, format string, args ...any) {
+		*changes = append(*changes, Change{Kind: kind, Command: strings.TrimSpace(strings.Join(path, " ")), Message: fmt.Sprintf(format, args...)})
+	}
+
+	if current == nil {
+		report(CommandRemoved, "command removed")
+		return
+	}
+
+	for _, alias := range previous.Aliases {
+		if alias != current.Name && !slices.Contains(current.Aliases, alias) {
+			report(CommandAliasRemoved, "alias %q removed", alias)
+		}
+	}
+
+	previousFlags := append(slices.Clone(previous.Flags), previousInherited...)
+	currentFlags := append(slices.Clone(current.Flags), currentInherited...)
+
+	for _, flag := range previousFlags {
+		compareFlag(report, flag, currentFlags)
+	}
+
+	if previous, current := requiredArgs(previous.Args, positionalArgs), requiredArgs(current.Args, positionalArgs); current > previous {
+		report(ArgsRequired, "%d positional arguments required instead of %d", current, previous)
+	}
+
+	if previous, current := requiredArgs(previous.Args, dashedArgs), requiredArgs(current.Args, dashedArgs); current > previous {
+		report(ArgsRequired, "%d dashed arguments required instead of %d", current, previous)
+	}
+
+	if previous, current := acceptedArgs(previous.Args, positionalArgs), acceptedArgs(current.Args, positionalArgs); current >= 0 && (previous < 0 || current < previous) {
+		report(ArgsRestricted, "at most %d positional arguments accepted instead of %s", current, acceptedArgsCount(previous))
+	}
+
+	if previous, current := acceptedArgs(previous.Args, dashedArgs), acceptedArgs(current.Args, dashedArgs); current >= 0 && (previous < 0 || current < previous) {
+		report(ArgsRestricted, "at most %d dashed arguments accepted instead of %s", current, acceptedArgsCount(previous))
+	}
+
+	previousInherited = append(slices.Clone(previousInherited), persistentFlags(previous.Flags)...)
+	currentInherited = append(slices.Clone(currentInherited), persistentFlags(current.Flags)...)
+
+	for _, sub := range previous.SubCommands {
+		compareCommand(changes, path, sub, findCommand(current.SubCommands, sub.Name), previousInherited, currentInherited)
+	}
+}
+
+// compareFlag compares the previous flag to the matching current flag, if any.
+func compareFlag(report func(kind ChangeKind, format string, args ...any), previous clischema.Flag, currentFlags []clischema.Flag) {
+	current := findFlag(currentFlags, previous)
+	if current == nil {
+		report(FlagRemoved, "flag %s removed", flagName(previous))
+		return
+	}
+
+	if previous.Type != current.Type {
+		report(FlagTypeChanged, "type of flag %s changed from %s to %s", flagName(previous), previous.Type, current.Type)
+	}
+
+	if previous.ShortName != "" && previous.ShortName != current.ShortName {
+		if other := findFlag(currentFlags, clischema.Flag{ShortName: previous.ShortName}); other != nil {
+			report(FlagShortNameChanged, "short name -%s of flag %s assigned to flag %s", previous.ShortName, flagName(previous), flagName(*other))
+		} else {
+			report(FlagShortNameChanged, "short name -%s of flag %s removed", previous.ShortName, flagName(previous))
+		}
+	}
+
+	for _, alias := range previous.Aliases {
+		if !slices.Contains(flagLongNames(*current), alias.Name) {
+			report(FlagAliasRemoved, "alias --%s of flag %s removed", alias.Name, flagName(previous))
+		}
+	}
+
+	if !previous.Required && current.Required {
+		report(FlagRequired, "flag %s is required", flagName(previous))
+	}
+}
+
+// findCommand returns the command named or aliased after the provided name, if any.
+func findCommand(commands []*clischema.Command, name string) *clischema.Command {
+	for _, cmd := range commands {
+		if cmd.Name == name || slices.Contains(cmd.Aliases, name) {
+			return cmd
+		}
+	}
+
+	return nil
+}
+
+// findFlag returns the flag matching the provided one by long name, or by short name for flags without long name, if any.
+func findFlag(flags []clischema.Flag, flag clischema.Flag) *clischema.Flag {
+	for i, f := range flags {
+		if (flag.LongName != "" && slices.Contains(flagLongNames(f), flag.LongName)) ||
+			(flag.LongName == "" && f.ShortName == flag.ShortName) {
+			return &flags[i]
+		}
+	}
+
+	return nil
+}
+
+// flagLongNames returns the long name and the aliases of the flag.
+func flagLongNames(flag clischema.Flag) []string {
+	var names []string
+
+	if flag.LongName != "" {
+		names = append(names, flag.LongName)
+	}
+
+	for _, alias := range flag.Aliases {
+		names = append(names, alias.Name)
+	}
+
+	return names
+}
+
+// flagName returns the name of the flag as used on the command line, like --port.
+func flagName(flag clischema.Flag) string {
+	if flag.LongName != "" {
+		return "--" + flag.LongName
+	}
+
+	return "-" + flag.ShortName
+}
+
+func persistentFlags(flags []clischema.Flag) []clischema.Flag {
+	var persistent []clischema.Flag
+
+	for _, flag := range flags {
+		if flag.Persistent {
+			persistent = append(persistent, flag)
+		}
+	}
+
+	return persistent
+}
+
+func positionalArgs(args *clischema.Args) *clischema.ArgList { return args.Positional }
+
+func dashedArgs(args *clischema.Args) *clischema.ArgList { return args.Dashed }
+
+// requiredArgs returns the number of arguments of the list selected by the provided function that can't be omitted.
+func requiredArgs(args *clischema.Args, list func(*clischema.Args) *clischema.ArgList) int {
+	if args == nil || list(args) == nil {
+		return 0
+	}
+
+	var required int
+
+	for _, arg := range list(args).Args {
+		if arg.Required {
+			required++
+		}
+	}
+
+	return required + list(args).MinVariadic
+}
+
+// acceptedArgs returns the maximum number of arguments of the list selected by the provided function,
+// or -1 if the number of arguments is unlimited, which is the case of commands without arguments specification.
+func acceptedArgs(args *clischema.Args, list func(*clischema.Args) *clischema.ArgList) int {
+	if args == nil || list(args) == nil {
+		return -1
+	}
+
+	switch l := list(args); {
+	case l.Variadic == nil:
+		return len(l.Args)
+	case l.MaxVariadic == 0:
+		return -1
+	default:
+		return len(l.Args) + l.MaxVariadic
+	}
+}
+
+func acceptedArgsCount(accepted int) string {
+	if accepted < 0 {
+		return "an unlimited number"
+	}
+
+	return strconv.Itoa(accepted)
+}
@@ -0,0 +1,196 @@
+package clicompat
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+
+	clischema "github.com/krostar/cli/schema"
+)
+
+func newSchema() *clischema.Schema {
+	return &clischema.Schema{Version: clischema.Version, Command: &clischema.Command{
+		Name: "app",
+		Flags: []clischema.Flag{
+			{LongName: "verbose", ShortName: "v", Type: "bool", Persistent: true},
+		},
+		SubCommands: []*clischema.Command{{
+			Name:    "serve",
+			Aliases: []string{"run", "start"},
+			Args: &clischema.Args{Positional: &clischema.ArgList{
+				Args: []clischema.Arg{{Name: "target", Type: "string", Required: true}},
+			}},
+			Flags: []clischema.Flag{
+				{LongName: "port", ShortName: "p", Type: "int", Aliases: []clischema.FlagAlias{{Name: "listen-port"}}},
+				{LongName: "host", Type: "string"},
+				{ShortName: "q", Type: "bool"},
+			},
+			SubCommands: []*clischema.Command{{Name: "api"}},
+		}},
+	}}
+}
+
+func Test_Compare(t *testing.T) {
+	for name, tc := range map[string]struct {
+		setup    func(schema *clischema.Schema)
+		change   func(schema *clischema.Schema)
+		expected []Change
+	}{
+		"no changes": {
+			change: func(*clischema.Schema) {},
+		},
+		"compatible changes": {
+			change: func(schema *clischema.Schema) {
+				serve := schema.Command.SubCommands[0]
+				serve.Name, serve.Aliases = "run", []string{"serve", "start", "up"}
+				serve.Description = "Serve things"
+				serve.Flags[0].LongName, serve.Flags[0].Aliases = "listen-port", []clischema.FlagAlias{{Name: "port", Deprecated: true}}
+				serve.Flags[1].ShortName = "H"
+				serve.Flags = append(serve.Flags, clischema.Flag{LongName: "tls", Type: "bool"})
+				serve.Args.Positional.Args = append(serve.Args.Positional.Args, clischema.Arg{Name: "files", Type: "string"})
+				serve.SubCommands = append(serve.SubCommands, &clischema.Command{Name: "ui"})
+			},
+		},
+		"flag moved to a parent command": {
+			change: func(schema *clischema.Schema) {
+				serve := schema.Command.SubCommands[0]
+				schema.Command.Flags = append(schema.Command.Flags, clischema.Flag{LongName: "host", Type: "string", Persistent: true})
+				serve.Flags = slices.Delete(serve.Flags, 1, 2)
+			},
+		},
+		"flag moved to a subcommand": {
+			change: func(schema *clischema.Schema) {
+				serve := schema.Command.SubCommands[0]
+				serve.Flags = append(serve.Flags, clischema.Flag{LongName: "verbose", ShortName: "v", Type: "bool", Persistent: true})
+				schema.Command.Flags = nil
+			},
+			expected: []Change{{Kind: FlagRemoved, Command: "app", Message: "flag --verbose removed"}},
+		},
+		"command removed": {
+			change: func(schema *clischema.Schema) { schema.Command.SubCommands[0].SubCommands = nil },
+			expected: []Change{
+				{Kind: CommandRemoved, Command: "app serve api", Message: "command removed"},
+			},
+		},
+		"command alias removed": {
+			change: func(schema *clischema.Schema) { schema.Command.SubCommands[0].Aliases = []string{"start"} },
+			expected: []Change{
+				{Kind: CommandAliasRemoved, Command: "app serve", Message: `alias "run" removed`},
+			},
+		},
+		"flags removed": {
+			change: func(schema *clischema.Schema) {
+				schema.Command.Flags = nil
+				schema.Command.SubCommands[0].Flags = schema.Command.SubCommands[0].Flags[:1]
+			},
+			expected: []Change{
+				{Kind: FlagRemoved, Command: "app", Message: "flag --verbose removed"},
+				{Kind: FlagRemoved, Command: "app serve", Message: "flag --host removed"},
+				{Kind: FlagRemoved, Command: "app serve", Message: "flag -q removed"},
+				{Kind: FlagRemoved, Command: "app serve", Message: "flag --verbose removed"},
+				{Kind: FlagRemoved, Command: "app serve api", Message: "flag --verbose removed"},
+			},
+		},
+		"flag type changed": {
+			change: func(schema *clischema.Schema) { schema.Command.SubCommands[0].Flags[0].Type = "string" },
+			expected: []Change{
+				{Kind: FlagTypeChanged, Command: "app serve", Message: "type of flag --port changed from int to string"},
+			},
+		},
+		"flag short name changed": {
+			change: func(schema *clischema.Schema) {
+				serve := schema.Command.SubCommands[0]
+				serve.Flags[0].ShortName = ""
+				serve.Flags[1].ShortName = "p"
+				schema.Command.Flags[0].ShortName = "V"
+			},
+			expected: []Change{
+				{Kind: FlagShortNameChanged, Command: "app", Message: "short name -v of flag --verbose removed"},
+				{Kind: FlagShortNameChanged, Command: "app serve", Message: "short name -p of flag --port assigned to flag --host"},
+				{Kind: FlagShortNameChanged, Command: "app serve", Message: "short name -v of flag --verbose removed"},
+				{Kind: FlagShortNameChanged, Command: "app serve api", Message: "short name -v of flag --verbose removed"},
+			},
+		},
+		"flag alias removed": {
+			change: func(schema *clischema.Schema) { schema.Command.SubCommands[0].Flags[0].Aliases = nil },
+			expected: []Change{
+				{Kind: FlagAliasRemoved, Command: "app serve", Message: "alias --listen-port of flag --port removed"},
+			},
+		},
+		"flag required": {
+			change: func(schema *clischema.Schema) { schema.Command.SubCommands[0].Flags[1].Required = true },
+			expected: []Change{
+				{Kind: FlagRequired, Command: "app serve", Message: "flag --host is required"},
+			},
+		},
+		"args required": {
+			change: func(schema *clischema.Schema) {
+				serve := schema.Command.SubCommands[0]
+				serve.Args.Positional.Variadic = &clischema.Arg{Name: "files", Type: "[]string", Required: true}
+				serve.Args.Positional.MinVariadic = 1
+				serve.Args.Dashed = &clischema.ArgList{Args: []clischema.Arg{{Name: "cmd", Type: "string", Required: true}}}
+				serve.SubCommands[0].Args = &clischema.Args{Positional: &clischema.ArgList{
+					Args: []clischema.Arg{{Name: "name", Type: "string", Required: true}, {Name: "version", Type: "string"}},
+				}}
+			},
+			expected: []Change{
+				{Kind: ArgsRequired, Command: "app serve", Message: "2 positional arguments required instead of 1"},
+				{Kind: ArgsRequired, Command: "app serve", Message: "1 dashed arguments required instead of 0"},
+				{Kind: ArgsRestricted, Command: "app serve", Message: "at most 1 dashed arguments accepted instead of an unlimited number"},
+				{Kind: ArgsRequired, Command: "app serve api", Message: "1 positional arguments required instead of 0"},
+				{Kind: ArgsRestricted, Command: "app serve api", Message: "at most 2 positional arguments accepted instead of an unlimited number"},
+			},
+		},
+		"args restricted": {
+			setup: func(schema *clischema.Schema) {
+				schema.Command.Args = &clischema.Args{Positional: &clischema.ArgList{Args: []clischema.Arg{{Name: "name", Type: "string"}}}}
+				serve := schema.Command.SubCommands[0]
+				serve.Args.Positional.Variadic = &clischema.Arg{Name: "files", Type: "[]string"}
+				serve.SubCommands[0].Args = &clischema.Args{Dashed: &clischema.ArgList{Variadic: &clischema.Arg{Name: "cmd", Type: "[]string"}, MaxVariadic: 3}}
+			},
+			change: func(schema *clischema.Schema) {
+				schema.Command.Args.Positional.Args = nil
+				serve := schema.Command.SubCommands[0]
+				serve.Args.Positional.Variadic = nil
+				serve.SubCommands[0].Args.Dashed.MaxVariadic = 2
+			},
+			expected: []Change{
+				{Kind: ArgsRestricted, Command: "app", Message: "at most 0 positional arguments accepted instead of 1"},
+				{Kind: ArgsRestricted, Command: "app serve", Message: "at most 1 positional arguments accepted instead of an unlimited number"},
+				{Kind: ArgsRestricted, Command: "app serve api", Message: "at most 2 dashed arguments accepted instead of 3"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			previous, current := newSchema(), newSchema()
+			if tc.setup != nil {
+				tc.setup(previous)
+				tc.setup(current)
+			}
+
+			tc.change(current)
+
+			changes := Compare(previous, current)
+			test.Assert(check.Compare(t, changes, tc.expected))
+		})
+	}
+
+	t.Run("missing commands", func(t *testing.T) {
+		removed := []Change{{Kind: CommandRemoved, Command: "app", Message: "command removed"}}
+
+		test.Assert(t, Compare(nil, newSchema()) == nil)
+		test.Assert(t, Compare(new(clischema.Schema), newSchema()) == nil)
+		test.Assert(check.Compare(t, Compare(newSchema(), nil), removed))
+		test.Assert(check.Compare(t, Compare(newSchema(), new(clischema.Schema)), removed))
+	})
+}
+
+func Test_Change_String(t *testing.T) {
+	change := Change{Kind: FlagRemoved, Command: "app serve", Message: "flag --port removed"}
+	test.Assert(t, change.String() == "app serve: flag --port removed")
+
+	change.Command = ""
+	test.Assert(t, change.String() == "flag --port removed")
+}
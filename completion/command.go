@@ -0,0 +1,88 @@
+package clicompletion
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/krostar/cli"
+)
+
+// CommandOption defines options to customize the command created with NewCommand.
+type CommandOption func(*command)
+
+// WithProgramName changes the name of the program scripts are generated for,
+// which is the base name of the executed binary by default.
+func WithProgramName(name string) CommandOption {
+	return func(c *command) { c.programName = name }
+}
+
+// WithWriter changes the writer scripts are written to, which is the standard output by default.
+func WithWriter(w io.Writer) CommandOption {
+	return func(c *command) { c.writer = w }
+}
+
+// NewCommand creates the command writing the completion script of the shell provided as argument.
+// Completion is opt-in: the command has to be added to the application, usually as "completion".
+//
+// Example:
+//
+//	cmd := cli.New(rootCommand{}).
+//	    AddCommand("serve", serveCommand{}).
+//	    AddCommand("completion", clicompletion.NewCommand())
+func NewCommand(opts ...CommandOption) cli.Command {
+	c := &command{programName: filepath.Base(os.Args[0]), writer: os.Stdout}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type command struct {
+	programName string
+	writer      io.Writer
+
+	shell string
+}
+
+func (c *command) Description() string {
+	return "Generate the shell completion script\n" +
+		"The script has to be sourced by the shell, usually from its configuration file. Supported shells are bash, zsh, fish and powershell."
+}
+
+func (c *command) Examples() []string {
+	return []string{
+		"completion bash > /etc/bash_completion.d/" + c.programName,
+		"completion zsh > \"${fpath[1]}/_" + c.programName + "\"",
+		"completion fish > ~/.config/fish/completions/" + c.programName + ".fish",
+		"completion powershell | Out-String | Invoke-Expression",
+	}
+}
+
+func (c *command) Args() *cli.ArgsSpec {
+	return &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{cli.NewArg("shell", &c.shell, "shell to generate the script for")}}}
+}
+
+func (*command) Complete(_ context.Context, args []string, _ string) ([]string, error) {
+	if len(args) > 0 {
+		return nil, nil
+	}
+
+	return Shells(), nil
+}
+
+func (c *command) Execute(context.Context, []string, []string) error {
+	script, err := Script(c.shell, c.programName)
+	if err != nil {
+		return cli.NewErrorWithHelp(err)
+	}
+
+	if _, err := io.WriteString(c.writer, script); err != nil {
+		return fmt.Errorf("unable to write completion script: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package clicompletion
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+)
+
+func Test_NewCommand(t *testing.T) {
+	newCommand := func(shell string, opts ...CommandOption) *command {
+		cmd := NewCommand(opts...).(*command)
+		cmd.shell = shell
+		return cmd
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		cmd := NewCommand().(*command)
+		test.Assert(t, cmd.programName != "" && cmd.writer != nil)
+	})
+
+	t.Run("script is written", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		cmd := newCommand("zsh", WithProgramName("app"), WithWriter(&buf))
+		test.Require(t, cmd.Execute(t.Context(), nil, nil) == nil)
+
+		expected, err := Script("zsh", "app")
+		test.Require(t, err == nil)
+		test.Assert(t, buf.String() == expected)
+		test.Assert(t, strings.Contains(strings.Join(cmd.Examples(), "\n"), "/_app"))
+	})
+
+	t.Run("unsupported shell", func(t *testing.T) {
+		err := newCommand("tcsh", WithWriter(new(bytes.Buffer))).Execute(t.Context(), nil, nil)
+
+		var showHelp interface{ ShowHelp() bool }
+		test.Assert(t, err != nil && errors.As(err, &showHelp) && showHelp.ShowHelp())
+	})
+
+	t.Run("write failure", func(t *testing.T) {
+		err := newCommand("fish", WithWriter(failingWriter{})).Execute(t.Context(), nil, nil)
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "unable to write completion script"))
+	})
+
+	t.Run("shells are completed", func(t *testing.T) {
+		cmd := NewCommand()
+
+		complete, ok := cmd.(cli.CommandCompletion)
+		test.Require(t, ok)
+
+		candidates, err := complete.Complete(t.Context(), nil, "")
+		test.Assert(t, err == nil && slices.Equal(candidates, Shells()))
+
+		candidates, err = complete.Complete(t.Context(), []string{"bash"}, "")
+		test.Assert(t, err == nil && candidates == nil)
+	})
+}
@@ -0,0 +1,182 @@
+// Package clicompletion provides shell completion for CLI applications, whatever the mapper used.
+//
+// Shells are configured by scripts, generated by Script or by the command returned by NewCommand,
+// that request the completion candidates to the application itself by executing the hidden
+// RequestCommandName command with the words being completed, like "app __complete serve --po".
+// Mappers answer these requests, using the CommandCompletion and FlagCompletion implementations
+// of the commands and flags (see the cli package), with one candidate per line followed by
+// the Directive telling the shell how to handle them, like ":4". This protocol is the one of
+// spf13/cobra, which answers the requests itself.
+package clicompletion
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RequestCommandName is the name of the hidden command executed by the scripts to request completion candidates.
+const RequestCommandName = "__complete"
+
+// Directive tells the shell how to handle the completion candidates.
+type Directive int
+
+const (
+	// DirectiveDefault lets the shell complete files when no candidates are provided.
+	DirectiveDefault Directive = 0
+	// DirectiveError indicates an error occurred, and candidates must be ignored.
+	DirectiveError Directive = 1 << 0
+	// DirectiveNoFileCompletion prevents the shell from completing files when no candidates are provided.
+	DirectiveNoFileCompletion Directive = 1 << 2
+)
+
+// WriteCandidates writes the provided candidates, one per line, followed by the directive, like ":4".
+func WriteCandidates(w io.Writer, candidates []string, directive Directive) error {
+	var out strings.Builder
+
+	for _, candidate := range candidates {
+		out.WriteString(candidate + "\n")
+	}
+
+	out.WriteString(":" + strconv.Itoa(int(directive)) + "\n")
+
+	if _, err := io.WriteString(w, out.String()); err != nil {
+		return fmt.Errorf("unable to write completion candidates: %w", err)
+	}
+
+	return nil
+}
+
+// Shells returns the shells scripts can be generated for.
+func Shells() []string { return []string{"bash", "zsh", "fish", "powershell"} }
+
+// Script returns the script configuring the completion of the program named name for the provided shell,
+// which must be one of the shells returned by Shells.
+func Script(shell, name string) (string, error) {
+	if name == "" {
+		return "", errors.New("program name must be non-empty")
+	}
+
+	script, exists := scripts[shell]
+	if !exists {
+		return "", fmt.Errorf("unsupported shell %q, supported shells are %s", shell, strings.Join(Shells(), ", "))
+	}
+
+	return strings.NewReplacer(
+		"{{name}}", name,
+		"{{function}}", invalidFunctionNameChars.ReplaceAllString(name, "_"),
+		"{{request}}", RequestCommandName,
+	).Replace(script), nil
+}
+
+// invalidFunctionNameChars matches the characters of the program name that can't be used in shell functions names.
+var invalidFunctionNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// scripts are the completion scripts templates, indexed by shell. Candidates are requested with
+// the words before the cursor, the last one being the word being completed, possibly empty.
+// Values of flags provided like --flag=value are completed without their --flag= prefix, and candidates
+// can be followed by a tab and their description, like spf13/cobra does.
+var scripts = map[string]string{
+	"bash": `# bash completion for {{name}}, to be sourced, like: source <({{name}} completion bash)
+_{{function}}_completion() {
+    local line="${COMP_LINE:0:COMP_POINT}" words=() lines=() candidates=() directive current
+    read -ra words <<< "$line"
+    [[ $line =~ [[:space:]]$ ]] && words+=("")
+    current="${words[-1]}"
+
+    mapfile -t lines < <("${words[0]}" {{request}} "${words[@]:1}" 2>/dev/null)
+    (( ${#lines[@]} == 0 )) && return
+    directive="${lines[-1]#:}"
+    candidates=("${lines[@]:0:${#lines[@]}-1}")
+    candidates=("${candidates[@]%%$'\t'*}")
+
+    (( directive & 1 )) && return
+    (( directive & 4 )) && compopt +o default 2>/dev/null
+
+    # bash splits words on = by default, otherwise the flag is part of the completed word
+    if [[ $current == -*=* && $COMP_WORDBREAKS != *=* ]]; then
+        candidates=("${candidates[@]/#/${current%%=*}=}")
+    fi
+
+    COMPREPLY=("${candidates[@]}")
+}
+
+complete -o default -F _{{function}}_completion {{name}}
+`,
+	"zsh": `#compdef {{name}}
+# zsh completion for {{name}}, to be sourced, like: source <({{name}} completion zsh)
+_{{function}}() {
+    local -a lines candidates
+    local directive
+
+    lines=("${(@f)$("${words[1]}" {{request}} "${(@)words[2,CURRENT]}" 2>/dev/null)}")
+    directive="${${lines[-1]#:}:-0}"
+    candidates=("${(@)lines[1,-2]}")
+    candidates=("${(@)candidates%%$'\t'*}")
+    candidates=("${(@)candidates:#}")
+
+    (( directive & 1 )) && return 1
+
+    if (( ${#candidates} == 0 )); then
+        (( directive & 4 )) && return 1
+        _files
+        return
+    fi
+
+    [[ ${words[CURRENT]} == -*=* ]] && compset -P '*='
+    compadd -- "${candidates[@]}"
+}
+
+compdef _{{function}} {{name}}
+`,
+	"fish": `# fish completion for {{name}}, to be sourced, like: {{name}} completion fish | source
+function __{{function}}_completion
+    set -l words (commandline -opc)
+    set -l current (commandline -ct)
+    set -l lines ($words[1] {{request}} $words[2..-1] "$current" 2>/dev/null)
+    test (count $lines) -gt 0; or return
+
+    set -l directive (string replace -r '^:' '' -- $lines[-1])
+    set -e lines[-1]
+    test (math "bitand($directive, 1)") -eq 0; or return
+
+    if test (count $lines) -eq 0
+        test (math "bitand($directive, 4)") -eq 0; and __fish_complete_path "$current"
+        return
+    end
+
+    set -l prefix (string match -r -- '^-[^=]*=' "$current")
+    printf "$prefix%s\n" $lines
+end
+
+complete -c {{name}} -f -a '(__{{function}}_completion)'
+`,
+	"powershell": `# powershell completion for {{name}}, to be sourced, like: {{name}} completion powershell | Out-String | Invoke-Expression
+Register-ArgumentCompleter -Native -CommandName '{{name}}' -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+
+    $words = @($commandAst.CommandElements | Where-Object { $_.Extent.StartOffset -lt $cursorPosition } | ForEach-Object { $_.ToString() })
+    if ($wordToComplete -eq '') {
+        # empty arguments are dropped by powershell before 7.3
+        $words += if ($PSVersionTable.PSVersion -lt [version]'7.3') { '""' } else { '' }
+    }
+
+    $program, $arguments = $words
+    $lines = @(& $program {{request}} @arguments 2>$null)
+    if ($lines.Count -eq 0) { return }
+
+    $directive = [int]($lines[-1].TrimStart(':'))
+    if ($directive -band 1) { return }
+
+    $prefix = if ($wordToComplete -match '^-[^=]*=') { $Matches[0] } else { '' }
+    $lines | Select-Object -First ($lines.Count - 1) | ForEach-Object {
+        $value, $description = $_ -split "` + "`" + `t", 2
+        if (-not $description) { $description = $value }
+        [System.Management.Automation.CompletionResult]::new("$prefix$value", $value, 'ParameterValue', $description)
+    }
+}
+`,
+}
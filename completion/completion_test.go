@@ -0,0 +1,54 @@
+package clicompletion
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+)
+
+func Test_WriteCandidates(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		test.Require(t, WriteCandidates(&buf, []string{"foo", "bar"}, DirectiveNoFileCompletion) == nil)
+		test.Assert(t, buf.String() == "foo\nbar\n:4\n", buf.String())
+
+		buf.Reset()
+		test.Require(t, WriteCandidates(&buf, nil, DirectiveDefault) == nil)
+		test.Assert(t, buf.String() == ":0\n", buf.String())
+	})
+
+	t.Run("ko", func(t *testing.T) {
+		err := WriteCandidates(failingWriter{}, []string{"foo"}, DirectiveDefault)
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "boom"))
+	})
+}
+
+func Test_Script(t *testing.T) {
+	for _, shell := range Shells() {
+		t.Run(shell, func(t *testing.T) {
+			script, err := Script(shell, "my-app")
+			test.Require(t, err == nil)
+			test.Assert(t, strings.Contains(script, "completion for my-app") && !strings.Contains(script, "_my-app"))
+			test.Assert(t, strings.Contains(script, RequestCommandName))
+			test.Assert(t, !strings.Contains(script, "{{"))
+		})
+	}
+
+	t.Run("unsupported shell", func(t *testing.T) {
+		_, err := Script("tcsh", "app")
+		test.Assert(t, err != nil && err.Error() == `unsupported shell "tcsh", supported shells are bash, zsh, fish, powershell`)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		_, err := Script("bash", "")
+		test.Assert(t, err != nil && err.Error() == "program name must be non-empty")
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("boom") }
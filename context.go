@@ -4,13 +4,17 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"slices"
 )
 
 type (
 	ctxKey     uint8
 	ctxCommand struct {
+		parent          *ctxCommand
 		localFlags      []Flag
 		persistentFlags []Flag
+		args            []Arg
+		configProvided  []any
 	}
 	ctxMetadata map[any]any
 )
@@ -24,7 +28,7 @@ const (
 // NewCommandContext is called for each command to create a dedicated context.
 // Warning: This does not make sens to use outside of cli mapper.
 func NewCommandContext(ctx context.Context) context.Context {
-	return context.WithValue(ctx, ctxKeyCommand, new(ctxCommand))
+	return context.WithValue(ctx, ctxKeyCommand, &ctxCommand{parent: getCommandFromContext(ctx)})
 }
 
 func getCommandFromContext(ctx context.Context) *ctxCommand {
@@ -58,6 +62,49 @@ func GetInitializedFlagsFromContext(ctx context.Context) ([]Flag, []Flag) {
 	return nil, nil
 }
 
+// SetInitializedArgsInContext stores the initialized arguments of a command in
+// the context. This allows the arguments to be accessed later, for example, by
+// configuration sources.
+// Warning: This function is exposed for cli mappers, you should not use it directly.
+func SetInitializedArgsInContext(ctx context.Context, spec *ArgsSpec) {
+	if cmd := getCommandFromContext(ctx); cmd != nil && spec != nil {
+		cmd.args = spec.All()
+	}
+}
+
+// GetInitializedArgsFromContext retrieves the initialized arguments of a
+// command from the context. Returns a nil slice if no arguments are found.
+// Warning: This function is exposed for sourcing argument values in configuration loader, you should not use it directly.
+func GetInitializedArgsFromContext(ctx context.Context) []Arg {
+	if cmd := getCommandFromContext(ctx); cmd != nil {
+		return cmd.args
+	}
+
+	return nil
+}
+
+// SetConfigProvidedInContext records that the values pointed by the provided destinations,
+// like the destinations of flags, have been provided by the configuration of the command.
+// Warning: This function is exposed for configuration loaders, you should not use it directly.
+func SetConfigProvidedInContext(ctx context.Context, destinations ...any) {
+	if cmd := getCommandFromContext(ctx); cmd != nil {
+		cmd.configProvided = append(cmd.configProvided, destinations...)
+	}
+}
+
+// IsConfigProvidedInContext returns whether the value pointed by the provided destination
+// has been provided by the configuration of the command, or of any of its parents.
+// Warning: This function is exposed for cli mappers, you should not use it directly.
+func IsConfigProvidedInContext(ctx context.Context, destination any) bool {
+	for cmd := getCommandFromContext(ctx); cmd != nil; cmd = cmd.parent {
+		if slices.Contains(cmd.configProvided, destination) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // NewContextWithMetadata creates a new context that includes a metadata
 // store. This store can be used to pass arbitrary data between different
 // parts of the CLI application. This function should be called at the
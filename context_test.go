@@ -33,6 +33,49 @@ func Test_ctxCommand(t *testing.T) {
 			test.Assert(t, len(local) == 2)
 			test.Assert(t, len(persistent) == 1)
 		}
+
+		{ // unprepared context for args
+			ctx := test.Context(t)
+			SetInitializedArgsInContext(ctx, &ArgsSpec{Positional: &ArgList{Args: []Arg{nil}}})
+			test.Assert(t, GetInitializedArgsFromContext(ctx) == nil)
+		}
+
+		{ // prepared context for args
+			ctx := NewCommandContext(test.Context(t))
+			SetInitializedArgsInContext(ctx, &ArgsSpec{
+				Positional: &ArgList{Args: []Arg{nil, nil}},
+				Dashed:     &ArgList{Variadic: NewArgWithValuer("a", nil, "")},
+			})
+			test.Assert(t, len(GetInitializedArgsFromContext(ctx)) == 3)
+		}
+	}
+}
+
+func Test_ctxCommand_configProvided(t *testing.T) {
+	var a, b string
+
+	{ // unprepared context
+		ctx := test.Context(t)
+		SetConfigProvidedInContext(ctx, &a)
+		test.Assert(t, !IsConfigProvidedInContext(ctx, &a))
+	}
+
+	{ // prepared context
+		parentCtx := NewCommandContext(test.Context(t))
+		childCtx := NewCommandContext(parentCtx)
+		test.Assert(t, !IsConfigProvidedInContext(parentCtx, &a) && !IsConfigProvidedInContext(childCtx, &a))
+
+		SetConfigProvidedInContext(childCtx, &a)
+		test.Assert(t, !IsConfigProvidedInContext(parentCtx, &a) && IsConfigProvidedInContext(childCtx, &a))
+		test.Assert(t, !IsConfigProvidedInContext(childCtx, &b))
+	}
+
+	{ // provided by a parent
+		parentCtx := NewCommandContext(test.Context(t))
+		childCtx := NewCommandContext(parentCtx)
+
+		SetConfigProvidedInContext(parentCtx, &a)
+		test.Assert(t, IsConfigProvidedInContext(parentCtx, &a) && IsConfigProvidedInContext(childCtx, &a))
 	}
 }
 
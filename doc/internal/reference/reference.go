@@ -0,0 +1,212 @@
+// Package reference builds the reference documentation of the visible commands of a CLI,
+// shared by the documentation generators rendering it in their own format.
+package reference
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
+)
+
+// Command is the reference documentation of a command.
+type Command struct {
+	// Path is the names of the commands leading to this one, from the root, this one included.
+	Path []string
+
+	ShortDescription string
+	Description      string
+	Usage            string
+	Aliases          []string
+	Examples         []string
+	Args             []Arg
+
+	// Flags are the visible flags of the command, its persistent flags included.
+	Flags []Flag
+	// InheritedFlags are the visible persistent flags of the parents, those shadowed by flags
+	// of the command or of nearer parents excluded.
+	InheritedFlags []Flag
+
+	// Parent is the parent command, nil for the root.
+	Parent *Command
+	// SubCommands are the visible subcommands.
+	SubCommands []*Command
+}
+
+// Name returns the full name of the command, like "app serve".
+func (c *Command) Name() string { return strings.Join(c.Path, " ") }
+
+// ID returns an identifier of the command usable in file names and anchors, like "app-serve".
+func (c *Command) ID() string { return strings.Join(c.Path, "-") }
+
+// Synopsis returns the usage lines of the command, like the help does.
+func (c *Command) Synopsis() []string {
+	synopsis := []string{strings.TrimSpace(c.Name() + " [flags] " + c.Usage)}
+	if len(c.SubCommands) > 0 {
+		synopsis = append(synopsis, c.Name()+" [command]")
+	}
+
+	return synopsis
+}
+
+// Arg is the reference documentation of a positional argument.
+type Arg struct {
+	Name        string
+	Description string
+}
+
+// Flag is the reference documentation of a flag.
+type Flag struct {
+	LongName  string
+	ShortName string
+	// Type is the type of the value of the flag, empty for flags not expecting a value like booleans.
+	Type string
+	// Default is the default value, empty if not worth documenting, see mapper.FlagDocumentedDefault.
+	Default string
+	// Description is the description of the flag, completed with its constraints, its requirements
+	// and the constraints of its groups, but not with its environment variables.
+	Description string
+	// Env is the names of the environment variables the flag is bound to.
+	Env []string
+	// Aliases is the additional long names of the flag, the deprecated ones excluded.
+	Aliases []string
+}
+
+// ID returns an identifier of the flag of the provided command usable in anchors, like "app-serve-port".
+func (f Flag) ID(c *Command) string { return c.ID() + "-" + cmp.Or(f.LongName, f.ShortName) }
+
+// Names returns the names of the flag, like "-p, --port".
+func (f Flag) Names() []string {
+	var names []string
+	if f.ShortName != "" {
+		names = append(names, "-"+f.ShortName)
+	}
+
+	if f.LongName != "" {
+		names = append(names, "--"+f.LongName)
+	}
+
+	return names
+}
+
+// Build returns the reference documentation of the visible commands of the provided CLI, parents before their subcommands.
+// The program name is used as the name of the root command if provided, the name of the root command must be set otherwise.
+func Build(ctx context.Context, c *cli.CLI, programName string) ([]*Command, error) {
+	if programName != "" {
+		root := *c
+		root.Name = programName
+		c = &root
+	}
+
+	if c.Name == "" {
+		return nil, errors.New("program name must be set, either as the name of the root command or with WithProgramName")
+	}
+
+	var (
+		commands []*Command
+		byNode   = make(map[*mapper.CommandNode]*Command)
+	)
+
+	if err := mapper.WalkCommands(ctx, c, func(_ context.Context, node *mapper.CommandNode) error {
+		if node.Hidden() {
+			return nil
+		}
+
+		cmd := newCommand(node)
+		if node.Parent != nil {
+			cmd.Parent = byNode[node.Parent]
+			cmd.Parent.SubCommands = append(cmd.Parent.SubCommands, cmd)
+		}
+
+		byNode[node] = cmd
+		commands = append(commands, cmd)
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("unable to walk commands: %w", err)
+	}
+
+	return commands, nil
+}
+
+// WriteDir writes the provided files, their content by their name, in the provided directory, which is created if needed.
+func WriteDir(dir string, files map[string]string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // documentation is meant to be readable by everyone
+		return fmt.Errorf("unable to create directory: %w", err)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(files[name]), 0o644); err != nil { //nolint:gosec // documentation is meant to be readable by everyone
+			return fmt.Errorf("unable to write page %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func newCommand(node *mapper.CommandNode) *Command {
+	cmd := node.CLI.Command
+	flags := slices.Concat(node.Flags, node.PersistentFlags)
+
+	c := &Command{
+		Path:             slices.Clone(node.Path),
+		ShortDescription: mapper.ShortDescription(cmd),
+		Description:      strings.TrimSpace(mapper.Description(cmd)),
+		Usage:            mapper.Usage(cmd),
+		Examples:         mapper.Examples(cmd),
+		Flags:            newFlags(flags),
+		InheritedFlags:   newFlags(mapper.InheritedFlagsNotShadowed(flags, node.InheritedFlags)),
+	}
+
+	if node.Parent != nil {
+		c.Aliases = mapper.Aliases(cmd)
+	}
+
+	if spec := mapper.Args(cmd); spec != nil {
+		for _, arg := range spec.All() {
+			c.Args = append(c.Args, Arg{Name: arg.Name(), Description: arg.Description()})
+		}
+	}
+
+	return c
+}
+
+func newFlags(flags []cli.Flag) []Flag {
+	var documented []Flag
+
+	for _, flag := range flags {
+		if mapper.FlagHidden(flag) {
+			continue
+		}
+
+		f := Flag{
+			LongName:    flag.LongName(),
+			ShortName:   flag.ShortName(),
+			Default:     mapper.FlagDocumentedDefault(flag),
+			Description: mapper.FlagDescriptionWithoutEnv(flag),
+			Env:         mapper.FlagEnvNames(flag),
+		}
+
+		if mapper.FlagExpectsValue(flag) {
+			f.Type = flag.TypeRepr()
+		}
+
+		for _, alias := range mapper.FlagAliases(flag) {
+			if !alias.Deprecated {
+				f.Aliases = append(f.Aliases, alias.Name)
+			}
+		}
+
+		documented = append(documented, f)
+	}
+
+	return documented
+}
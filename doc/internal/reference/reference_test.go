@@ -0,0 +1,102 @@
+package reference
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+	"github.com/krostar/cli/internal/clitest"
+)
+
+func Test_Build(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		commands, err := Build(t.Context(), clitest.NewCLI(), "app")
+		test.Require(t, err == nil, err)
+		test.Require(t, len(commands) == 2, commands)
+
+		root, serve := commands[0], commands[1]
+		test.Assert(check.Compare(t, root.Path, []string{"app"}))
+		test.Assert(t, root.Parent == nil && len(root.SubCommands) == 1 && root.SubCommands[0] == serve)
+		test.Assert(check.Compare(t, serve.Path, []string{"app", "serve"}))
+		test.Assert(t, serve.Parent == root && len(serve.SubCommands) == 0)
+	})
+
+	t.Run("shadowed inherited flags", func(t *testing.T) {
+		var s string
+
+		c := cli.New(double.NewFake(double.FakeWithPersistentFlags(func() []cli.Flag {
+			return []cli.Flag{
+				cli.NewBuiltinFlag("output", "o", &s, ""),
+				cli.NewBuiltinFlag("config", "c", &s, ""),
+				cli.NewBuiltinFlag("verbose", "v", &s, ""),
+			}
+		}))).AddCommand("sub", double.NewFake(double.FakeWithFlags(func() []cli.Flag {
+			return []cli.Flag{
+				cli.NewBuiltinFlag("output", "", &s, ""),
+				cli.NewBuiltinFlag("format", "", &s, "", cli.WithAliases("config")),
+			}
+		})))
+
+		commands, err := Build(t.Context(), c, "app")
+		test.Require(t, err == nil, err)
+		test.Require(t, len(commands) == 2, commands)
+
+		sub := commands[1]
+		test.Assert(t, len(sub.Flags) == 2, sub.Flags)
+		test.Assert(t, len(sub.InheritedFlags) == 1 && sub.InheritedFlags[0].LongName == "verbose", sub.InheritedFlags)
+	})
+
+	t.Run("program name is required", func(t *testing.T) {
+		_, err := Build(t.Context(), cli.New(double.NewFake()), "")
+		test.Assert(t, err != nil && err.Error() == "program name must be set, either as the name of the root command or with WithProgramName", err)
+	})
+
+	t.Run("hook failure", func(t *testing.T) {
+		c := cli.New(double.NewFake(double.FakeWithPersistentHook(func() *cli.PersistentHook {
+			return &cli.PersistentHook{BeforeFlagsDefinition: func(context.Context) error { return errors.New("boom") }}
+		})))
+
+		_, err := Build(t.Context(), c, "app")
+		test.Assert(t, err != nil && err.Error() == "unable to walk commands: pre-flag-definition hook of command app failed: boom", err)
+	})
+}
+
+func Test_WriteDir(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "docs")
+
+		test.Require(t, WriteDir(dir, map[string]string{"b.txt": "b", "a.txt": "a"}) == nil)
+
+		entries, err := os.ReadDir(dir)
+		test.Require(t, err == nil, err)
+		test.Assert(t, len(entries) == 2 && entries[0].Name() == "a.txt" && entries[1].Name() == "b.txt", entries)
+
+		content, err := os.ReadFile(filepath.Join(dir, "b.txt"))
+		test.Require(t, err == nil, err)
+		test.Assert(t, string(content) == "b")
+	})
+
+	t.Run("unable to create directory", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "file")
+		test.Require(t, os.WriteFile(file, nil, 0o600) == nil)
+
+		err := WriteDir(filepath.Join(file, "docs"), map[string]string{"a.txt": "a"})
+		test.Assert(t, err != nil && strings.HasPrefix(err.Error(), "unable to create directory"), err)
+	})
+
+	t.Run("unable to write page", func(t *testing.T) {
+		dir := t.TempDir()
+		test.Require(t, os.Mkdir(filepath.Join(dir, "a.txt"), 0o700) == nil)
+
+		err := WriteDir(dir, map[string]string{"a.txt": "a"})
+		test.Assert(t, err != nil && strings.HasPrefix(err.Error(), "unable to write page a.txt"), err)
+	})
+}
@@ -0,0 +1,262 @@
+// Package docman generates man pages from a CLI, whatever the mapper used to execute it.
+//
+// One roff page of section 1 is generated for each visible command, named after the path of
+// the command, like app-serve.1, with its description, usage, arguments, flags (including the
+// ones inherited from parent commands), examples, and links to the parent and subcommands.
+// Hidden and deprecated commands and flags are not documented.
+//
+// Pages are generated deterministically: they do not contain the generation date unless
+// provided with WithDate, so that they can be generated and compared in tests.
+package docman
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/doc/internal/reference"
+)
+
+// Page is a generated man page.
+type Page struct {
+	// Name is the name of the file of the page, like app-serve.1.
+	Name string
+	// Content is the roff content of the page.
+	Content string
+}
+
+// Option defines options to customize the generated pages.
+type Option func(*options)
+
+// WithProgramName sets the name of the program, which is the name of the root command by default.
+func WithProgramName(name string) Option {
+	return func(o *options) { o.programName = name }
+}
+
+// WithDate sets the date displayed in the footer of the pages, which is empty by default.
+func WithDate(date time.Time) Option {
+	return func(o *options) { o.date = date }
+}
+
+// WithSource sets the source displayed in the footer of the pages, usually the program name and version, like "app 1.2.3".
+func WithSource(source string) Option {
+	return func(o *options) { o.source = source }
+}
+
+// WithManual sets the title of the manual displayed in the header of the pages, like "App Manual".
+func WithManual(manual string) Option {
+	return func(o *options) { o.manual = manual }
+}
+
+type options struct {
+	programName string
+	date        time.Time
+	source      string
+	manual      string
+}
+
+// Generate returns the man pages of the visible commands of the provided CLI, parents before their subcommands.
+// Like during executions, the BeforeFlagsDefinition persistent hook of each command runs before the flags of the
+// command are created. No other hook runs.
+// The program name is the name of the root command, it must be set or provided with WithProgramName.
+func Generate(ctx context.Context, c *cli.CLI, opts ...Option) ([]Page, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	commands, err := reference.Build(ctx, c, o.programName)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]Page, len(commands))
+	for i, cmd := range commands {
+		pages[i] = Page{Name: cmd.ID() + ".1", Content: page(cmd, o)}
+	}
+
+	return pages, nil
+}
+
+// WriteDir generates the man pages of the provided CLI, see Generate, and writes them in the provided directory,
+// which is created if needed.
+func WriteDir(ctx context.Context, c *cli.CLI, dir string, opts ...Option) error {
+	pages, err := Generate(ctx, c, opts...)
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]string, len(pages))
+	for _, page := range pages {
+		files[page.Name] = page.Content
+	}
+
+	return reference.WriteDir(dir, files)
+}
+
+// page returns the roff content of the page of the provided command.
+func page(cmd *reference.Command, o options) string {
+	var b strings.Builder
+
+	var date string
+	if !o.date.IsZero() {
+		date = o.date.Format("Jan 2006")
+	}
+
+	_, _ = fmt.Fprintf(&b, ".TH %s 1 %s %s %s\n", quote(strings.ToUpper(cmd.ID())), quote(date), quote(o.source), quote(o.manual))
+	b.WriteString(".nh\n.ad l\n")
+
+	b.WriteString(".SH NAME\n")
+	b.WriteString(escape(cmd.ID()))
+
+	if cmd.ShortDescription != "" {
+		b.WriteString(` \- ` + escape(cmd.ShortDescription))
+	}
+
+	b.WriteString("\n")
+
+	b.WriteString(".SH SYNOPSIS\n")
+
+	for i, line := range cmd.Synopsis() {
+		if i > 0 {
+			b.WriteString(".br\n")
+		}
+
+		name := cmd.Name()
+		b.WriteString(`\fB` + escape(name) + `\fP` + escape(strings.TrimPrefix(line, name)) + "\n")
+	}
+
+	if cmd.Description != "" {
+		b.WriteString(".SH DESCRIPTION\n")
+		writeParagraphs(&b, cmd.Description)
+	}
+
+	if len(cmd.Aliases) > 0 {
+		b.WriteString(".SH ALIASES\n")
+		b.WriteString(escape(strings.Join(append([]string{cmd.Path[len(cmd.Path)-1]}, cmd.Aliases...), ", ")) + "\n")
+	}
+
+	if len(cmd.Args) > 0 {
+		b.WriteString(".SH ARGUMENTS\n")
+
+		for _, arg := range cmd.Args {
+			b.WriteString(".TP\n" + `\fI` + escape(arg.Name) + `\fP` + "\n")
+			writeText(&b, arg.Description)
+		}
+	}
+
+	writeFlags(&b, "OPTIONS", cmd.Flags)
+	writeFlags(&b, "OPTIONS INHERITED FROM PARENT COMMANDS", cmd.InheritedFlags)
+
+	if len(cmd.Examples) > 0 {
+		b.WriteString(".SH EXAMPLES\n")
+
+		for _, example := range cmd.Examples {
+			b.WriteString(".PP\n.RS\n.nf\n")
+			writeText(&b, example)
+			b.WriteString(".fi\n.RE\n")
+		}
+	}
+
+	writeSeeAlso(&b, cmd)
+
+	return b.String()
+}
+
+// writeFlags writes a section listing the provided flags, if any.
+func writeFlags(b *strings.Builder, title string, flags []reference.Flag) {
+	if len(flags) == 0 {
+		return
+	}
+
+	b.WriteString(".SH " + title + "\n")
+
+	for _, flag := range flags {
+		names := flag.Names()
+		for i, name := range names {
+			names[i] = `\fB` + escape(name) + `\fP`
+		}
+
+		repr := strings.Join(names, ", ")
+		if flag.Type != "" {
+			repr += ` \fI` + escape(flag.Type) + `\fP`
+		}
+
+		description := flag.Description
+		if len(flag.Env) > 0 {
+			description += " [$" + strings.Join(flag.Env, ", $") + "]"
+		}
+
+		if flag.Default != "" {
+			description += " (default " + flag.Default + ")"
+		}
+
+		if len(flag.Aliases) > 0 {
+			description += " (aliases: --" + strings.Join(flag.Aliases, ", --") + ")"
+		}
+
+		b.WriteString(".TP\n" + repr + "\n")
+		writeText(b, strings.TrimSpace(description))
+	}
+}
+
+// writeSeeAlso writes the links to the parent command and the subcommands, if any.
+func writeSeeAlso(b *strings.Builder, cmd *reference.Command) {
+	var links []string
+
+	if cmd.Parent != nil {
+		links = append(links, link(cmd.Parent))
+	}
+
+	for _, sub := range cmd.SubCommands {
+		links = append(links, link(sub))
+	}
+
+	if len(links) > 0 {
+		b.WriteString(".SH SEE ALSO\n" + strings.Join(links, ",\n") + "\n")
+	}
+}
+
+// writeParagraphs writes the provided text, paragraphs being separated by blank lines.
+func writeParagraphs(b *strings.Builder, text string) {
+	for i, paragraph := range strings.Split(text, "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph == "" {
+			continue
+		}
+
+		if i > 0 {
+			b.WriteString(".PP\n")
+		}
+
+		writeText(b, paragraph)
+	}
+}
+
+// writeText writes the provided text, line by line, lines being escaped.
+func writeText(b *strings.Builder, text string) {
+	for line := range strings.SplitSeq(text, "\n") {
+		line = escape(line)
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			line = `\&` + line
+		}
+
+		b.WriteString(line + "\n")
+	}
+}
+
+// link returns a reference to the page of the provided command, like \fBapp-serve(1)\fP.
+func link(cmd *reference.Command) string {
+	return `\fB` + escape(cmd.ID()) + `(1)\fP`
+}
+
+// quote returns the provided header argument quoted, its quotes being escaped.
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(escape(s), `"`, `\(dq`) + `"`
+}
+
+// escape escapes the roff special characters of the provided text.
+func escape(s string) string { return roffEscaper.Replace(s) }
+
+var roffEscaper = strings.NewReplacer(`\`, `\e`, `-`, `\-`)
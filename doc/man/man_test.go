@@ -0,0 +1,120 @@
+package docman
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+	"github.com/krostar/cli/internal/clitest"
+)
+
+func Test_Generate(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		pages, err := Generate(t.Context(), clitest.NewCLI(),
+			WithProgramName("app"),
+			WithDate(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)),
+			WithSource("app 1.2.3"),
+			WithManual("App Manual"),
+		)
+		test.Require(t, err == nil, err)
+		test.Require(t, len(pages) == 2, pages)
+
+		test.Assert(t, pages[0].Name == "app.1")
+		test.Assert(check.Compare(t, pages[0].Content, `.TH "APP" 1 "Mar 2025" "app 1.2.3" "App Manual"
+.nh
+.ad l
+.SH NAME
+app \- My application
+.SH SYNOPSIS
+\fBapp\fP [flags]
+.br
+\fBapp\fP [command]
+.SH DESCRIPTION
+My application
+.PP
+It serves *things*.
+.SH OPTIONS
+.TP
+\fB\-v\fP, \fB\-\-verbose\fP
+Enable verbose logs [$APP_VERBOSE]
+.SH SEE ALSO
+\fBapp\-serve(1)\fP
+`))
+
+		test.Assert(t, pages[1].Name == "app-serve.1")
+		test.Assert(check.Compare(t, pages[1].Content, `.TH "APP\-SERVE" 1 "Mar 2025" "app 1.2.3" "App Manual"
+.nh
+.ad l
+.SH NAME
+app\-serve \- Serve things
+.SH SYNOPSIS
+\fBapp serve\fP [flags] <target> [files...]
+.SH DESCRIPTION
+Serve things
+\&.ini files are read
+.SH ALIASES
+serve, run
+.SH ARGUMENTS
+.TP
+\fItarget\fP
+Target to serve
+.TP
+\fIfiles\fP
+Files to serve
+.SH OPTIONS
+.TP
+\fB\-p\fP, \fB\-\-port\fP \fIint\fP
+Port to listen on (1\-65535) [$APP_PORT, $PORT] (default 8080) (aliases: \-\-listen\-port)
+.TP
+\fB\-\-host\fP \fIstring\fP
+Host_name to listen on | interface (required)
+.SH OPTIONS INHERITED FROM PARENT COMMANDS
+.TP
+\fB\-v\fP, \fB\-\-verbose\fP
+Enable verbose logs [$APP_VERBOSE]
+.SH EXAMPLES
+.PP
+.RS
+.nf
+app serve \-\-port 8080 api
+.fi
+.RE
+.SH SEE ALSO
+\fBapp(1)\fP
+`))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		c := cli.New(double.NewFake())
+		c.Name = "app"
+
+		pages, err := Generate(t.Context(), c)
+		test.Require(t, err == nil, err)
+		test.Assert(check.Compare(t, pages, []Page{{
+			Name:    "app.1",
+			Content: ".TH \"APP\" 1 \"\" \"\" \"\"\n.nh\n.ad l\n.SH NAME\napp\n.SH SYNOPSIS\n\\fBapp\\fP [flags]\n",
+		}}))
+	})
+}
+
+func Test_WriteDir(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "man1")
+
+		test.Require(t, WriteDir(t.Context(), clitest.NewCLI(), dir, WithProgramName("app")) == nil)
+
+		entries, err := os.ReadDir(dir)
+		test.Require(t, err == nil, err)
+		test.Assert(t, len(entries) == 2 && entries[0].Name() == "app-serve.1" && entries[1].Name() == "app.1", entries)
+	})
+
+	t.Run("ko", func(t *testing.T) {
+		test.Assert(t, WriteDir(t.Context(), clitest.NewCLI(), t.TempDir()) != nil)
+	})
+}
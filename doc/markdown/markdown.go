@@ -0,0 +1,262 @@
+// Package docmarkdown generates Markdown reference documentation from a CLI, whatever the mapper used to execute it.
+//
+// One page is generated for each visible command, named after the path of the command like app-serve.md,
+// or a single page documenting all of them with WithSinglePage. Pages describe the usage, arguments,
+// flags (including the ones inherited from parent commands) and examples of the commands, each flag
+// having an anchor like #app-serve-port. Hidden and deprecated commands and flags are not documented.
+//
+// Pages are generated deterministically, so that they can be checked in version control and compared
+// in tests to detect outdated documentation.
+package docmarkdown
+
+import (
+	"context"
+	"strings"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/doc/internal/reference"
+)
+
+// Page is a generated Markdown page.
+type Page struct {
+	// Name is the name of the file of the page, like app-serve.md.
+	Name string
+	// Content is the Markdown content of the page.
+	Content string
+}
+
+// PageInfo describes a page for which front matter is generated, see WithFrontMatter.
+type PageInfo struct {
+	// Name is the name of the file of the page, like app-serve.md.
+	Name string
+	// Command is the full name of the command documented by the page, like "app serve",
+	// which is the root command for single pages.
+	Command string
+	// ShortDescription is the first line of the description of the command.
+	ShortDescription string
+}
+
+// Option defines options to customize the generated pages.
+type Option func(*options)
+
+// WithProgramName sets the name of the program, which is the name of the root command by default.
+func WithProgramName(name string) Option {
+	return func(o *options) { o.programName = name }
+}
+
+// WithSinglePage generates a single page, named after the program like app.md, documenting all the commands.
+func WithSinglePage() Option {
+	return func(o *options) { o.singlePage = true }
+}
+
+// WithFrontMatter adds the front matter returned by the provided function at the beginning of each page,
+// for static site generators.
+//
+// Example:
+//
+//	docmarkdown.WithFrontMatter(func(page docmarkdown.PageInfo) string {
+//	    return "---\ntitle: " + page.Command + "\n---\n"
+//	})
+func WithFrontMatter(frontMatter func(page PageInfo) string) Option {
+	return func(o *options) { o.frontMatter = frontMatter }
+}
+
+type options struct {
+	programName string
+	singlePage  bool
+	frontMatter func(page PageInfo) string
+}
+
+// Generate returns the Markdown pages of the visible commands of the provided CLI, parents before their subcommands.
+// The flags of each command are created once its BeforeFlagsDefinition persistent hook ran, as when the CLI
+// is executed, and the other hooks never run.
+// The program name is the name of the root command, it must be set or provided with WithProgramName.
+func Generate(ctx context.Context, c *cli.CLI, opts ...Option) ([]Page, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	commands, err := reference.Build(ctx, c, o.programName)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.singlePage {
+		var b strings.Builder
+
+		root := commands[0]
+		info := PageInfo{Name: pageName(root), Command: root.Name(), ShortDescription: root.ShortDescription}
+
+		writeFrontMatter(&b, info, o)
+		b.WriteString("# " + root.Name() + "\n")
+
+		for _, cmd := range commands {
+			b.WriteString("\n")
+			writeCommand(&b, cmd, "##", func(cmd *reference.Command) string { return "#" + cmd.ID() })
+		}
+
+		return []Page{{Name: info.Name, Content: b.String()}}, nil
+	}
+
+	pages := make([]Page, len(commands))
+
+	for i, cmd := range commands {
+		var b strings.Builder
+
+		info := PageInfo{Name: pageName(cmd), Command: cmd.Name(), ShortDescription: cmd.ShortDescription}
+
+		writeFrontMatter(&b, info, o)
+		writeCommand(&b, cmd, "#", pageName)
+
+		pages[i] = Page{Name: info.Name, Content: b.String()}
+	}
+
+	return pages, nil
+}
+
+// WriteDir generates the Markdown pages of the provided CLI, see Generate, and writes them in the provided directory,
+// which is created if needed.
+func WriteDir(ctx context.Context, c *cli.CLI, dir string, opts ...Option) error {
+	pages, err := Generate(ctx, c, opts...)
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]string, len(pages))
+	for _, page := range pages {
+		files[page.Name] = page.Content
+	}
+
+	return reference.WriteDir(dir, files)
+}
+
+// writeFrontMatter writes the front matter of the page, if any.
+func writeFrontMatter(b *strings.Builder, info PageInfo, o options) {
+	if o.frontMatter == nil {
+		return
+	}
+
+	if frontMatter := o.frontMatter(info); frontMatter != "" {
+		b.WriteString(strings.TrimRight(frontMatter, "\n") + "\n\n")
+	}
+}
+
+// writeCommand writes the documentation of the provided command, its title being at the provided heading level
+// and its sections one level below. Links to other commands are created with the provided function.
+func writeCommand(b *strings.Builder, cmd *reference.Command, heading string, link func(cmd *reference.Command) string) {
+	section := heading + "#"
+
+	b.WriteString(anchor(cmd.ID()) + "\n")
+	b.WriteString(heading + " " + cmd.Name() + "\n")
+
+	if cmd.Description != "" {
+		b.WriteString("\n" + cmd.Description + "\n")
+	}
+
+	b.WriteString("\n" + section + " Usage\n\n```\n" + strings.Join(cmd.Synopsis(), "\n") + "\n```\n")
+
+	if len(cmd.Aliases) > 0 {
+		b.WriteString("\n" + section + " Aliases\n\n")
+		b.WriteString(code(append([]string{cmd.Path[len(cmd.Path)-1]}, cmd.Aliases...)...) + "\n")
+	}
+
+	if len(cmd.Args) > 0 {
+		b.WriteString("\n" + section + " Arguments\n\n| Argument | Description |\n| --- | --- |\n")
+
+		for _, arg := range cmd.Args {
+			b.WriteString("| " + code(arg.Name) + " | " + cell(arg.Description) + " |\n")
+		}
+	}
+
+	writeFlags(b, section+" Flags", cmd, cmd.Flags)
+	writeFlags(b, section+" Inherited flags", cmd, cmd.InheritedFlags)
+
+	if len(cmd.Examples) > 0 {
+		b.WriteString("\n" + section + " Examples\n\n```\n" + strings.Join(cmd.Examples, "\n") + "\n```\n")
+	}
+
+	var seeAlso []*reference.Command
+	if cmd.Parent != nil {
+		seeAlso = append(seeAlso, cmd.Parent)
+	}
+
+	if seeAlso = append(seeAlso, cmd.SubCommands...); len(seeAlso) > 0 {
+		b.WriteString("\n" + section + " See also\n\n")
+
+		for _, other := range seeAlso {
+			b.WriteString("- [" + other.Name() + "](" + link(other) + ")")
+
+			if other.ShortDescription != "" {
+				b.WriteString(": " + other.ShortDescription)
+			}
+
+			b.WriteString("\n")
+		}
+	}
+}
+
+// writeFlags writes a table listing the provided flags of the command, if any.
+func writeFlags(b *strings.Builder, title string, cmd *reference.Command, flags []reference.Flag) {
+	if len(flags) == 0 {
+		return
+	}
+
+	b.WriteString("\n" + title + "\n\n| Flag | Type | Default | Environment | Description |\n| --- | --- | --- | --- | --- |\n")
+
+	for _, flag := range flags {
+		description := flag.Description
+		if len(flag.Aliases) > 0 {
+			aliases := make([]string, len(flag.Aliases))
+			for i, alias := range flag.Aliases {
+				aliases[i] = "--" + alias
+			}
+
+			description = strings.TrimSpace(description + " (aliases: " + code(aliases...) + ")")
+		}
+
+		env := make([]string, len(flag.Env))
+		for i, name := range flag.Env {
+			env[i] = "$" + name
+		}
+
+		b.WriteString("| " + strings.Join([]string{
+			anchor(flag.ID(cmd)) + code(flag.Names()...),
+			code(flag.Type),
+			code(flag.Default),
+			code(env...),
+			cell(description),
+		}, " | ") + " |\n")
+	}
+}
+
+// pageName returns the name of the file of the page of the provided command, like app-serve.md.
+func pageName(cmd *reference.Command) string { return cmd.ID() + ".md" }
+
+// anchor returns an HTML anchor with the provided identifier.
+func anchor(id string) string { return `<a id="` + id + `"></a>` }
+
+// code returns the provided non-empty values as code spans usable in table cells, separated by commas.
+func code(values ...string) string {
+	var spans []string
+
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+
+		value = strings.ReplaceAll(value, "|", `\|`)
+		if strings.Contains(value, "`") {
+			spans = append(spans, "`` "+value+" ``")
+		} else {
+			spans = append(spans, "`"+value+"`")
+		}
+	}
+
+	return strings.Join(spans, ", ")
+}
+
+// cell returns the provided text usable in table cells.
+func cell(text string) string {
+	return strings.NewReplacer("|", `\|`, "\n", "<br>").Replace(text)
+}
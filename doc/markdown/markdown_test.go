@@ -0,0 +1,146 @@
+package docmarkdown
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+
+	"github.com/krostar/cli/internal/clitest"
+)
+
+const (
+	expectedRootPage = `<a id="app"></a>
+# app
+
+My application
+
+It serves *things*.
+
+## Usage
+
+` + "```" + `
+app [flags]
+app [command]
+` + "```" + `
+
+## Flags
+
+| Flag | Type | Default | Environment | Description |
+| --- | --- | --- | --- | --- |
+| <a id="app-verbose"></a>` + "`-v`, `--verbose`" + ` |  |  | ` + "`$APP_VERBOSE`" + ` | Enable verbose logs |
+
+## See also
+
+- [app serve](app-serve.md): Serve things
+`
+
+	expectedServePage = `<a id="app-serve"></a>
+# app serve
+
+Serve things
+.ini files are read
+
+## Usage
+
+` + "```" + `
+app serve [flags] <target> [files...]
+` + "```" + `
+
+## Aliases
+
+` + "`serve`, `run`" + `
+
+## Arguments
+
+| Argument | Description |
+| --- | --- |
+| ` + "`target`" + ` | Target to serve |
+| ` + "`files`" + ` | Files to serve |
+
+## Flags
+
+| Flag | Type | Default | Environment | Description |
+| --- | --- | --- | --- | --- |
+| <a id="app-serve-port"></a>` + "`-p`, `--port` | `int` | `8080` | `$APP_PORT`, `$PORT` | Port to listen on (1-65535) (aliases: `--listen-port`)" + ` |
+| <a id="app-serve-host"></a>` + "`--host` | `string` |  |  | Host_name to listen on \\| interface (required)" + ` |
+
+## Inherited flags
+
+| Flag | Type | Default | Environment | Description |
+| --- | --- | --- | --- | --- |
+| <a id="app-serve-verbose"></a>` + "`-v`, `--verbose`" + ` |  |  | ` + "`$APP_VERBOSE`" + ` | Enable verbose logs |
+
+## Examples
+
+` + "```" + `
+app serve --port 8080 api
+` + "```" + `
+
+## See also
+
+- [app](app.md): My application
+`
+)
+
+func Test_Generate(t *testing.T) {
+	t.Run("page per command", func(t *testing.T) {
+		pages, err := Generate(t.Context(), clitest.NewCLI(), WithProgramName("app"))
+		test.Require(t, err == nil, err)
+		test.Assert(check.Compare(t, pages, []Page{
+			{Name: "app.md", Content: expectedRootPage},
+			{Name: "app-serve.md", Content: expectedServePage},
+		}))
+	})
+
+	t.Run("single page", func(t *testing.T) {
+		pages, err := Generate(t.Context(), clitest.NewCLI(), WithProgramName("app"), WithSinglePage())
+		test.Require(t, err == nil, err)
+		test.Require(t, len(pages) == 1)
+		test.Assert(t, pages[0].Name == "app.md")
+
+		shift := func(page string) string {
+			return strings.NewReplacer("\n# ", "\n## ", "\n## ", "\n### ", "(app.md)", "(#app)", "(app-serve.md)", "(#app-serve)").Replace(page)
+		}
+		test.Assert(check.Compare(t, pages[0].Content, "# app\n\n"+shift(expectedRootPage)+"\n"+shift(expectedServePage)))
+	})
+
+	t.Run("front matter", func(t *testing.T) {
+		var infos []PageInfo
+
+		pages, err := Generate(t.Context(), clitest.NewCLI(), WithProgramName("app"), WithFrontMatter(func(page PageInfo) string {
+			infos = append(infos, page)
+			if page.Command == "app" {
+				return ""
+			}
+
+			return "---\ntitle: " + page.Command + "\n---"
+		}))
+		test.Require(t, err == nil, err)
+		test.Assert(check.Compare(t, infos, []PageInfo{
+			{Name: "app.md", Command: "app", ShortDescription: "My application"},
+			{Name: "app-serve.md", Command: "app serve", ShortDescription: "Serve things"},
+		}))
+		test.Assert(t, pages[0].Content == expectedRootPage)
+		test.Assert(t, pages[1].Content == "---\ntitle: app serve\n---\n\n"+expectedServePage)
+	})
+}
+
+func Test_WriteDir(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "docs")
+
+		test.Require(t, WriteDir(t.Context(), clitest.NewCLI(), dir, WithProgramName("app")) == nil)
+
+		content, err := os.ReadFile(filepath.Join(dir, "app-serve.md"))
+		test.Require(t, err == nil, err)
+		test.Assert(t, string(content) == expectedServePage)
+	})
+
+	t.Run("ko", func(t *testing.T) {
+		test.Assert(t, WriteDir(t.Context(), clitest.NewCLI(), t.TempDir()) != nil)
+	})
+}
@@ -0,0 +1,301 @@
+// Package docrst generates reStructuredText reference documentation from a CLI, whatever the mapper used to execute it.
+//
+// One page is generated for each visible command, named after the path of the command like app-serve.rst,
+// or a single page documenting all of them with WithSinglePage. Pages describe the usage, arguments,
+// flags (including the ones inherited from parent commands) and examples of the commands. Commands
+// and flags have labels, like app-serve and app-serve-port, pages linking to each other with the
+// Sphinx :ref: role. Hidden and deprecated commands and flags are not documented.
+//
+// Pages are generated deterministically, so that they can be checked in version control and compared
+// in tests to detect outdated documentation.
+package docrst
+
+import (
+	"context"
+	"strings"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/doc/internal/reference"
+)
+
+// Page is a generated reStructuredText page.
+type Page struct {
+	// Name is the name of the file of the page, like app-serve.rst.
+	Name string
+	// Content is the reStructuredText content of the page.
+	Content string
+}
+
+// PageInfo describes a page for which front matter is generated, see WithFrontMatter.
+type PageInfo struct {
+	// Name is the name of the file of the page, like app-serve.rst.
+	Name string
+	// Command is the full name of the command documented by the page, like "app serve",
+	// which is the root command for single pages.
+	Command string
+	// ShortDescription is the first line of the description of the command.
+	ShortDescription string
+}
+
+// Option defines options to customize the generated pages.
+type Option func(*options)
+
+// WithProgramName sets the name of the program, which is the name of the root command by default.
+func WithProgramName(name string) Option {
+	return func(o *options) { o.programName = name }
+}
+
+// WithSinglePage generates a single page, named after the program like app.rst, documenting all the commands.
+// Links between commands of single pages do not require Sphinx.
+func WithSinglePage() Option {
+	return func(o *options) { o.singlePage = true }
+}
+
+// WithFrontMatter adds the front matter returned by the provided function at the beginning of each page,
+// like Sphinx metadata fields.
+//
+// Example:
+//
+//	docrst.WithFrontMatter(func(page docrst.PageInfo) string {
+//	    return ":description: " + page.ShortDescription
+//	})
+func WithFrontMatter(frontMatter func(page PageInfo) string) Option {
+	return func(o *options) { o.frontMatter = frontMatter }
+}
+
+type options struct {
+	programName string
+	singlePage  bool
+	frontMatter func(page PageInfo) string
+}
+
+// Generate returns the reStructuredText pages of the visible commands of the provided CLI, parents before their
+// subcommands.
+// For each command, the BeforeFlagsDefinition persistent hook runs first, then the flags are created, like mappers
+// do. The other hooks are not run.
+// The program name is the name of the root command, it must be set or provided with WithProgramName.
+func Generate(ctx context.Context, c *cli.CLI, opts ...Option) ([]Page, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	commands, err := reference.Build(ctx, c, o.programName)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.singlePage {
+		var b strings.Builder
+
+		root := commands[0]
+		info := PageInfo{Name: pageName(root), Command: root.Name(), ShortDescription: root.ShortDescription}
+
+		writeFrontMatter(&b, info, o)
+
+		adornment := strings.Repeat("=", len(root.Name()))
+		b.WriteString(adornment + "\n" + root.Name() + "\n" + adornment + "\n")
+
+		for _, cmd := range commands {
+			b.WriteString("\n")
+			writeCommand(&b, cmd, func(cmd *reference.Command) string { return "`" + cmd.Name() + " <" + cmd.ID() + "_>`_" })
+		}
+
+		return []Page{{Name: info.Name, Content: b.String()}}, nil
+	}
+
+	pages := make([]Page, len(commands))
+
+	for i, cmd := range commands {
+		var b strings.Builder
+
+		info := PageInfo{Name: pageName(cmd), Command: cmd.Name(), ShortDescription: cmd.ShortDescription}
+
+		writeFrontMatter(&b, info, o)
+		writeCommand(&b, cmd, func(cmd *reference.Command) string { return ":ref:`" + cmd.Name() + " <" + cmd.ID() + ">`" })
+
+		pages[i] = Page{Name: info.Name, Content: b.String()}
+	}
+
+	return pages, nil
+}
+
+// WriteDir generates the reStructuredText pages of the provided CLI, see Generate, and writes them in the provided
+// directory, which is created if needed.
+func WriteDir(ctx context.Context, c *cli.CLI, dir string, opts ...Option) error {
+	pages, err := Generate(ctx, c, opts...)
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]string, len(pages))
+	for _, page := range pages {
+		files[page.Name] = page.Content
+	}
+
+	return reference.WriteDir(dir, files)
+}
+
+// writeFrontMatter writes the front matter of the page, if any.
+func writeFrontMatter(b *strings.Builder, info PageInfo, o options) {
+	if o.frontMatter == nil {
+		return
+	}
+
+	if frontMatter := o.frontMatter(info); frontMatter != "" {
+		b.WriteString(strings.TrimRight(frontMatter, "\n") + "\n\n")
+	}
+}
+
+// writeCommand writes the documentation of the provided command. Links to other commands are created with the provided function.
+func writeCommand(b *strings.Builder, cmd *reference.Command, link func(cmd *reference.Command) string) {
+	b.WriteString(".. _" + cmd.ID() + ":\n\n")
+	b.WriteString(cmd.Name() + "\n" + strings.Repeat("=", len(cmd.Name())) + "\n")
+
+	if cmd.Description != "" {
+		b.WriteString("\n" + escape(cmd.Description) + "\n")
+	}
+
+	writeSection(b, "Usage")
+	writeLiteralBlock(b, cmd.Synopsis())
+
+	if len(cmd.Aliases) > 0 {
+		writeSection(b, "Aliases")
+		b.WriteString(literals(append([]string{cmd.Path[len(cmd.Path)-1]}, cmd.Aliases...)...) + "\n")
+	}
+
+	if len(cmd.Args) > 0 {
+		writeSection(b, "Arguments")
+
+		rows := [][]string{{"Argument", "Description"}}
+		for _, arg := range cmd.Args {
+			rows = append(rows, []string{literals(arg.Name), escape(arg.Description)})
+		}
+
+		writeTable(b, rows)
+	}
+
+	writeFlags(b, "Flags", cmd, cmd.Flags)
+	writeFlags(b, "Inherited flags", cmd, cmd.InheritedFlags)
+
+	if len(cmd.Examples) > 0 {
+		writeSection(b, "Examples")
+		writeLiteralBlock(b, cmd.Examples)
+	}
+
+	var seeAlso []*reference.Command
+	if cmd.Parent != nil {
+		seeAlso = append(seeAlso, cmd.Parent)
+	}
+
+	if seeAlso = append(seeAlso, cmd.SubCommands...); len(seeAlso) > 0 {
+		writeSection(b, "See also")
+
+		for _, other := range seeAlso {
+			b.WriteString("- " + link(other))
+
+			if other.ShortDescription != "" {
+				b.WriteString(": " + escape(other.ShortDescription))
+			}
+
+			b.WriteString("\n")
+		}
+	}
+}
+
+// writeFlags writes a section with a table listing the provided flags of the command, if any.
+func writeFlags(b *strings.Builder, title string, cmd *reference.Command, flags []reference.Flag) {
+	if len(flags) == 0 {
+		return
+	}
+
+	writeSection(b, title)
+
+	rows := [][]string{{"Flag", "Type", "Default", "Environment", "Description"}}
+
+	for _, flag := range flags {
+		description := escape(flag.Description)
+		if len(flag.Aliases) > 0 {
+			aliases := make([]string, len(flag.Aliases))
+			for i, alias := range flag.Aliases {
+				aliases[i] = "--" + alias
+			}
+
+			description = strings.TrimSpace(description + " (aliases: " + literals(aliases...) + ")")
+		}
+
+		env := make([]string, len(flag.Env))
+		for i, name := range flag.Env {
+			env[i] = "$" + name
+		}
+
+		rows = append(rows, []string{
+			".. _" + flag.ID(cmd) + ":\n\n" + literals(flag.Names()...),
+			literals(flag.Type),
+			literals(flag.Default),
+			literals(env...),
+			description,
+		})
+	}
+
+	writeTable(b, rows)
+}
+
+// writeSection writes the title of a section of the documentation of a command.
+func writeSection(b *strings.Builder, title string) {
+	b.WriteString("\n" + title + "\n" + strings.Repeat("-", len(title)) + "\n\n")
+}
+
+// writeLiteralBlock writes the provided lines as a literal block.
+func writeLiteralBlock(b *strings.Builder, lines []string) {
+	b.WriteString("::\n\n")
+
+	for _, line := range lines {
+		for subLine := range strings.SplitSeq(line, "\n") {
+			b.WriteString(strings.TrimRight("   "+subLine, " ") + "\n")
+		}
+	}
+}
+
+// writeTable writes the provided rows as a list table, the first one being the header.
+func writeTable(b *strings.Builder, rows [][]string) {
+	b.WriteString(".. list-table::\n   :header-rows: 1\n\n")
+
+	for _, row := range rows {
+		for i, cell := range row {
+			prefix := "     - "
+			if i == 0 {
+				prefix = "   * - "
+			}
+
+			for j, line := range strings.Split(cell, "\n") {
+				if j > 0 {
+					prefix = strings.Repeat(" ", len(prefix))
+				}
+
+				b.WriteString(strings.TrimRight(prefix+line, " ") + "\n")
+			}
+		}
+	}
+}
+
+// pageName returns the name of the file of the page of the provided command, like app-serve.rst.
+func pageName(cmd *reference.Command) string { return cmd.ID() + ".rst" }
+
+// literals returns the provided non-empty values as inline literals, separated by commas.
+func literals(values ...string) string {
+	var formatted []string
+
+	for _, value := range values {
+		if value != "" {
+			formatted = append(formatted, "``"+value+"``")
+		}
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// escape escapes the reStructuredText inline markup characters of the provided text.
+func escape(text string) string { return rstEscaper.Replace(text) }
+
+var rstEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "`", "\\`", "|", `\|`, "_", `\_`)
@@ -0,0 +1,205 @@
+package docrst
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+
+	"github.com/krostar/cli/internal/clitest"
+)
+
+const (
+	expectedRootPage = `.. _app:
+
+app
+===
+
+My application
+
+It serves \*things\*.
+
+Usage
+-----
+
+::
+
+   app [flags]
+   app [command]
+
+Flags
+-----
+
+.. list-table::
+   :header-rows: 1
+
+   * - Flag
+     - Type
+     - Default
+     - Environment
+     - Description
+   * - .. _app-verbose:
+
+       ` + "``-v``, ``--verbose``" + `
+     -
+     -
+     - ` + "``$APP_VERBOSE``" + `
+     - Enable verbose logs
+
+See also
+--------
+
+- :ref:` + "`app serve <app-serve>`" + `: Serve things
+`
+
+	expectedServePage = `.. _app-serve:
+
+app serve
+=========
+
+Serve things
+.ini files are read
+
+Usage
+-----
+
+::
+
+   app serve [flags] <target> [files...]
+
+Aliases
+-------
+
+` + "``serve``, ``run``" + `
+
+Arguments
+---------
+
+.. list-table::
+   :header-rows: 1
+
+   * - Argument
+     - Description
+   * - ` + "``target``" + `
+     - Target to serve
+   * - ` + "``files``" + `
+     - Files to serve
+
+Flags
+-----
+
+.. list-table::
+   :header-rows: 1
+
+   * - Flag
+     - Type
+     - Default
+     - Environment
+     - Description
+   * - .. _app-serve-port:
+
+       ` + "``-p``, ``--port``" + `
+     - ` + "``int``" + `
+     - ` + "``8080``" + `
+     - ` + "``$APP_PORT``, ``$PORT``" + `
+     - Port to listen on (1-65535) (aliases: ` + "``--listen-port``" + `)
+   * - .. _app-serve-host:
+
+       ` + "``--host``" + `
+     - ` + "``string``" + `
+     -
+     -
+     - Host\_name to listen on \| interface (required)
+
+Inherited flags
+---------------
+
+.. list-table::
+   :header-rows: 1
+
+   * - Flag
+     - Type
+     - Default
+     - Environment
+     - Description
+   * - .. _app-serve-verbose:
+
+       ` + "``-v``, ``--verbose``" + `
+     -
+     -
+     - ` + "``$APP_VERBOSE``" + `
+     - Enable verbose logs
+
+Examples
+--------
+
+::
+
+   app serve --port 8080 api
+
+See also
+--------
+
+- :ref:` + "`app <app>`" + `: My application
+`
+)
+
+func Test_Generate(t *testing.T) {
+	t.Run("page per command", func(t *testing.T) {
+		pages, err := Generate(t.Context(), clitest.NewCLI(), WithProgramName("app"))
+		test.Require(t, err == nil, err)
+		test.Assert(check.Compare(t, pages, []Page{
+			{Name: "app.rst", Content: expectedRootPage},
+			{Name: "app-serve.rst", Content: expectedServePage},
+		}))
+	})
+
+	t.Run("single page", func(t *testing.T) {
+		pages, err := Generate(t.Context(), clitest.NewCLI(), WithProgramName("app"), WithSinglePage())
+		test.Require(t, err == nil, err)
+		test.Require(t, len(pages) == 1)
+		test.Assert(t, pages[0].Name == "app.rst")
+
+		links := strings.NewReplacer(":ref:`app <app>`", "`app <app_>`_", ":ref:`app serve <app-serve>`", "`app serve <app-serve_>`_")
+		test.Assert(check.Compare(t, pages[0].Content, "===\napp\n===\n\n"+links.Replace(expectedRootPage)+"\n"+links.Replace(expectedServePage)))
+	})
+
+	t.Run("front matter", func(t *testing.T) {
+		var infos []PageInfo
+
+		pages, err := Generate(t.Context(), clitest.NewCLI(), WithProgramName("app"), WithFrontMatter(func(page PageInfo) string {
+			infos = append(infos, page)
+			if page.Command == "app" {
+				return ""
+			}
+
+			return ":orphan:\n"
+		}))
+		test.Require(t, err == nil, err)
+		test.Assert(check.Compare(t, infos, []PageInfo{
+			{Name: "app.rst", Command: "app", ShortDescription: "My application"},
+			{Name: "app-serve.rst", Command: "app serve", ShortDescription: "Serve things"},
+		}))
+		test.Assert(t, pages[0].Content == expectedRootPage)
+		test.Assert(t, pages[1].Content == ":orphan:\n\n"+expectedServePage)
+	})
+}
+
+func Test_WriteDir(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "docs")
+
+		test.Require(t, WriteDir(t.Context(), clitest.NewCLI(), dir, WithProgramName("app")) == nil)
+
+		content, err := os.ReadFile(filepath.Join(dir, "app-serve.rst"))
+		test.Require(t, err == nil, err)
+		test.Assert(t, string(content) == expectedServePage)
+	})
+
+	t.Run("ko", func(t *testing.T) {
+		test.Assert(t, WriteDir(t.Context(), clitest.NewCLI(), t.TempDir()) != nil)
+	})
+}
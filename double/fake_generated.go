@@ -12,7 +12,8 @@ import (
 //
 // By default, the fake command only implements the basic Execute method,
 // returning nil. Use the FakeWith* options to implement additional interfaces
-// and customize behavior.
+// and customize behavior. Additional interfaces are provided through cli.CommandWrapper,
+// they are not implemented directly: use cli.AsCommand to get them.
 func NewFake(opts ...FakeOption) cli.Command {
 	f := new(fakeAllInterfaces)
 	f.onExecute = func(context.Context, []string, []string) error { return nil }
@@ -22,10 +23,15 @@ func NewFake(opts ...FakeOption) cli.Command {
 	}
 
 	return reduceWrapped(f,
+		f.onAliases != nil,
+		f.onArgs != nil,
+		f.onComplete != nil,
 		f.onContext != nil,
+		f.onDeprecation != nil,
 		f.onDescription != nil,
 		f.onExamples != nil,
 		f.onFlags != nil,
+		f.onHidden != nil,
 		f.onHook != nil,
 		f.onPersistentFlags != nil,
 		f.onPersistentHook != nil,
@@ -36,12 +42,36 @@ func NewFake(opts ...FakeOption) cli.Command {
 // FakeOption is a function to configures a fake behavior.
 type FakeOption func(f *fakeAllInterfaces)
 
+// FakeWithAliases configures the fake to implement the Aliases method.
+// The provided function will be called when the Aliases method is invoked.
+func FakeWithAliases(f func() []string) FakeOption {
+	return func(fake *fakeAllInterfaces) { fake.onAliases = f }
+}
+
+// FakeWithArgs configures the fake to implement the Args method.
+// The provided function will be called when the Args method is invoked.
+func FakeWithArgs(f func() *cli.ArgsSpec) FakeOption {
+	return func(fake *fakeAllInterfaces) { fake.onArgs = f }
+}
+
+// FakeWithComplete configures the fake to implement the Complete method.
+// The provided function will be called when the Complete method is invoked.
+func FakeWithComplete(f func(context.Context, []string, string) ([]string, error)) FakeOption {
+	return func(fake *fakeAllInterfaces) { fake.onComplete = f }
+}
+
 // FakeWithContext configures the fake to implement the Context method.
 // The provided function will be called when the Context method is invoked.
 func FakeWithContext(f func(context.Context) context.Context) FakeOption {
 	return func(fake *fakeAllInterfaces) { fake.onContext = f }
 }
 
+// FakeWithDeprecation configures the fake to implement the Deprecation method.
+// The provided function will be called when the Deprecation method is invoked.
+func FakeWithDeprecation(f func() string) FakeOption {
+	return func(fake *fakeAllInterfaces) { fake.onDeprecation = f }
+}
+
 // FakeWithDescription configures the fake to implement the Description method.
 // The provided function will be called when the Description method is invoked.
 func FakeWithDescription(f func() string) FakeOption {
@@ -66,6 +96,12 @@ func FakeWithFlags(f func() []cli.Flag) FakeOption {
 	return func(fake *fakeAllInterfaces) { fake.onFlags = f }
 }
 
+// FakeWithHidden configures the fake to implement the Hidden method.
+// The provided function will be called when the Hidden method is invoked.
+func FakeWithHidden(f func() bool) FakeOption {
+	return func(fake *fakeAllInterfaces) { fake.onHidden = f }
+}
+
 // FakeWithHook configures the fake to implement the Hook method.
 // The provided function will be called when the Hook method is invoked.
 func FakeWithHook(f func() *cli.Hook) FakeOption {
@@ -91,29 +127,46 @@ func FakeWithUsage(f func() string) FakeOption {
 }
 
 type fakeAllInterfaces struct {
+	onAliases         func() []string
+	onArgs            func() *cli.ArgsSpec
+	onComplete        func(context.Context, []string, string) ([]string, error)
 	onContext         func(context.Context) context.Context
+	onDeprecation     func() string
 	onDescription     func() string
 	onExamples        func() []string
 	onExecute         func(context.Context, []string, []string) error
 	onFlags           func() []cli.Flag
+	onHidden          func() bool
 	onHook            func() *cli.Hook
 	onPersistentFlags func() []cli.Flag
 	onPersistentHook  func() *cli.PersistentHook
 	onUsage           func() string
 }
 
+func (fake *fakeAllInterfaces) Aliases() []string { return fake.onAliases() }
+
+func (fake *fakeAllInterfaces) Args() *cli.ArgsSpec { return fake.onArgs() }
+
+func (fake *fakeAllInterfaces) Complete(a0 context.Context, b0 []string, c0 string) ([]string, error) {
+	return fake.onComplete(a0, b0, c0)
+}
+
 func (fake *fakeAllInterfaces) Context(a0 context.Context) context.Context { return fake.onContext(a0) }
 
+func (fake *fakeAllInterfaces) Deprecation() string { return fake.onDeprecation() }
+
 func (fake *fakeAllInterfaces) Description() string { return fake.onDescription() }
 
 func (fake *fakeAllInterfaces) Examples() []string { return fake.onExamples() }
 
-func (fake *fakeAllInterfaces) Execute(a0 context.Context, b0, c0 []string) error {
+func (fake *fakeAllInterfaces) Execute(a0 context.Context, b0 []string, c0 []string) error {
 	return fake.onExecute(a0, b0, c0)
 }
 
 func (fake *fakeAllInterfaces) Flags() []cli.Flag { return fake.onFlags() }
 
+func (fake *fakeAllInterfaces) Hidden() bool { return fake.onHidden() }
+
 func (fake *fakeAllInterfaces) Hook() *cli.Hook { return fake.onHook() }
 
 func (fake *fakeAllInterfaces) PersistentFlags() []cli.Flag { return fake.onPersistentFlags() }
@@ -18,16 +18,51 @@ func Test_Fake(t *testing.T) {
 			test.Assert(t, f.Execute(t.Context(), []string{}, []string{}) == nil)
 		}, nil)))
 
-		_, okContext := f.(cli.CommandContext)
-		_, okDescription := f.(cli.CommandDescription)
-		_, okExamples := f.(cli.CommandExamples)
-		_, okFlags := f.(cli.CommandFlags)
-		_, okHook := f.(cli.CommandHook)
-		_, okPersistentFlags := f.(cli.CommandPersistentFlags)
-		_, okPersistentHook := f.(cli.CommandPersistentHook)
-		_, okUsage := f.(cli.CommandUsage)
-
-		test.Assert(t, !okContext && !okDescription && !okExamples && !okFlags && !okHook && !okPersistentFlags && !okPersistentHook && !okUsage)
+		_, okAliases := cli.AsCommand[cli.CommandAliases](f)
+		_, okArgs := cli.AsCommand[cli.CommandArgs](f)
+		_, okComplete := cli.AsCommand[cli.CommandCompletion](f)
+		_, okContext := cli.AsCommand[cli.CommandContext](f)
+		_, okDescription := cli.AsCommand[cli.CommandDescription](f)
+		_, okExamples := cli.AsCommand[cli.CommandExamples](f)
+		_, okFlags := cli.AsCommand[cli.CommandFlags](f)
+		_, okHook := cli.AsCommand[cli.CommandHook](f)
+		_, okPersistentFlags := cli.AsCommand[cli.CommandPersistentFlags](f)
+		_, okPersistentHook := cli.AsCommand[cli.CommandPersistentHook](f)
+		_, okUsage := cli.AsCommand[cli.CommandUsage](f)
+
+		test.Assert(t, !okAliases && !okArgs && !okComplete && !okContext && !okDescription && !okExamples && !okFlags && !okHook && !okPersistentFlags && !okPersistentHook && !okUsage)
+	})
+
+	t.Run("FakeWithAliases", func(t *testing.T) {
+		aliases := []string{"hello", "world"}
+
+		f := NewFake(FakeWithAliases(func() []string { return aliases }))
+
+		fAliases, okAliases := cli.AsCommand[cli.CommandAliases](f)
+		test.Assert(t, okAliases)
+		test.Assert(check.Compare(t, fAliases.Aliases(), aliases))
+	})
+
+	t.Run("FakeWithArgs", func(t *testing.T) {
+		spec := &cli.ArgsSpec{Positional: &cli.ArgList{Optional: 1}}
+
+		f := NewFake(FakeWithArgs(func() *cli.ArgsSpec { return spec }))
+
+		fArgs, okArgs := cli.AsCommand[cli.CommandArgs](f)
+		test.Assert(t, okArgs && fArgs.Args() == spec)
+	})
+
+	t.Run("FakeWithComplete", func(t *testing.T) {
+		candidates := []string{"hello", "world"}
+
+		f := NewFake(FakeWithComplete(func(context.Context, []string, string) ([]string, error) { return candidates, nil }))
+
+		fComplete, okComplete := cli.AsCommand[cli.CommandCompletion](f)
+		test.Assert(t, okComplete)
+
+		got, err := fComplete.Complete(t.Context(), nil, "")
+		test.Assert(t, err == nil)
+		test.Assert(check.Compare(t, got, candidates))
 	})
 
 	t.Run("FakeWithContext", func(t *testing.T) {
@@ -35,7 +70,7 @@ func Test_Fake(t *testing.T) {
 
 		f := NewFake(FakeWithContext(func(context.Context) context.Context { return ctx }))
 
-		fContext, okContext := f.(cli.CommandContext)
+		fContext, okContext := cli.AsCommand[cli.CommandContext](f)
 		test.Assert(t, okContext && fContext.Context(t.Context()) == ctx)
 	})
 
@@ -44,7 +79,7 @@ func Test_Fake(t *testing.T) {
 
 		f := NewFake(FakeWithDescription(func() string { return description }))
 
-		fDescription, okDescription := f.(cli.CommandDescription)
+		fDescription, okDescription := cli.AsCommand[cli.CommandDescription](f)
 		test.Assert(t, okDescription && fDescription.Description() == description)
 	})
 
@@ -53,7 +88,7 @@ func Test_Fake(t *testing.T) {
 
 		f := NewFake(FakeWithExamples(func() []string { return examples }))
 
-		fExamples, okExamples := f.(cli.CommandExamples)
+		fExamples, okExamples := cli.AsCommand[cli.CommandExamples](f)
 		test.Assert(t, okExamples)
 		test.Assert(check.Compare(t, fExamples.Examples(), examples))
 	})
@@ -63,7 +98,7 @@ func Test_Fake(t *testing.T) {
 
 		f := NewFake(FakeWithFlags(func() []cli.Flag { return flags }))
 
-		fFlags, okFlags := f.(cli.CommandFlags)
+		fFlags, okFlags := cli.AsCommand[cli.CommandFlags](f)
 		test.Assert(t, okFlags && len(fFlags.Flags()) == len(flags))
 	})
 
@@ -72,7 +107,7 @@ func Test_Fake(t *testing.T) {
 
 		f := NewFake(FakeWithHook(func() *cli.Hook { return hook }))
 
-		fHook, okHook := f.(cli.CommandHook)
+		fHook, okHook := cli.AsCommand[cli.CommandHook](f)
 		test.Assert(t, okHook && fHook.Hook() == hook)
 	})
 
@@ -81,7 +116,7 @@ func Test_Fake(t *testing.T) {
 
 		f := NewFake(FakeWithPersistentFlags(func() []cli.Flag { return flags }))
 
-		fPersistentFlags, okPersistentFlags := f.(cli.CommandPersistentFlags)
+		fPersistentFlags, okPersistentFlags := cli.AsCommand[cli.CommandPersistentFlags](f)
 		test.Assert(t, okPersistentFlags && len(fPersistentFlags.PersistentFlags()) == len(flags))
 	})
 
@@ -90,7 +125,7 @@ func Test_Fake(t *testing.T) {
 
 		f := NewFake(FakeWithPersistentHook(func() *cli.PersistentHook { return hook }))
 
-		fPersistentHook, okPersistentHook := f.(cli.CommandPersistentHook)
+		fPersistentHook, okPersistentHook := cli.AsCommand[cli.CommandPersistentHook](f)
 		test.Assert(t, okPersistentHook && fPersistentHook.PersistentHook() == hook)
 	})
 
@@ -99,7 +134,7 @@ func Test_Fake(t *testing.T) {
 
 		f := NewFake(FakeWithUsage(func() string { return usage }))
 
-		fUsage, okUsage := f.(cli.CommandUsage)
+		fUsage, okUsage := cli.AsCommand[cli.CommandUsage](f)
 		test.Assert(t, okUsage && fUsage.Usage() == usage)
 	})
 }
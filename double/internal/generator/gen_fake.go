@@ -1,12 +1,9 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"go/types"
 	"maps"
-	"os"
-	"os/exec"
 	"slices"
 	"strings"
 	"text/template"
@@ -26,25 +23,23 @@ type templateFakeDataMethod struct {
 	OutputTypes    []string
 }
 
-func generateFakeImplementation(ctx context.Context, mainCommand *types.Interface, otherCommandInterfaces map[string]*types.Interface, destFilePath string) error {
+func generateFakeImplementation(mainCommand *types.Interface, otherCommandInterfaces map[string]*types.Interface, destFilePath string) error {
 	mainCommandName := "Command"
 	tmpl := template.Must(template.New("spy").Funcs(template.FuncMap{
+		"imports": importsDeclaration,
 		"strjoin": strings.Join,
 	}).Parse(`// Code generated by double/internal/generator DO NOT EDIT.
 
 package double
 
-import (
-{{- range .Imports }}
-	"{{ . }}"
-{{- end }}
-)
+{{ imports .Imports }}
 
 // NewFake creates a new fake implementation of the cli.Command interface.
 //
 // By default, the fake command only implements the basic Execute method,
 // returning nil. Use the FakeWith* options to implement additional interfaces
-// and customize behavior.
+// and customize behavior. Additional interfaces are provided through cli.CommandWrapper,
+// they are not implemented directly: use cli.AsCommand to get them.
 func NewFake(opts ...FakeOption) cli.Command {
 	f := new(fakeAllInterfaces)
 	f.onExecute = func(context.Context, []string, []string) error { return nil }
@@ -87,8 +82,8 @@ func (fake *fakeAllInterfaces) {{ $name }}({{ strjoin $method.InputVarsTypes ",
 	imports["github.com/krostar/cli"] = "cli"
 
 	data.Methods = make(map[string]templateFakeDataMethod)
-	for ifaceName, iface := range allInterfaces {
-		for method := range iface.Methods() {
+	for _, ifaceName := range slices.Sorted(maps.Keys(allInterfaces)) {
+		for method := range allInterfaces[ifaceName].Methods() {
 			sig := method.Signature()
 
 			inputVars, inputTypes, inputVarsTypes := getTupleRepresentation(sig.Params(), imports, 0)
@@ -110,21 +105,10 @@ func (fake *fakeAllInterfaces) {{ $name }}({{ strjoin $method.InputVarsTypes ",
 		}
 	}
 
-	slices.Sort(data.ReduceMethods)
 	data.Imports = slices.Sorted(maps.Keys(imports))
 
-	file, err := os.Create(destFilePath)
-	if err != nil {
-		return fmt.Errorf("unable to open output file: %w", err)
-	}
-	defer file.Close()
-
-	if err := tmpl.Execute(file, data); err != nil {
-		return fmt.Errorf("unable to execute template: %w", err)
-	}
-
-	if err := exec.CommandContext(ctx, "just", "fmt", destFilePath).Run(); err != nil {
-		return fmt.Errorf("unable to format output: %w", err)
+	if err := writeGoFile(tmpl, data, destFilePath); err != nil {
+		return fmt.Errorf("unable to write fake: %w", err)
 	}
 
 	return nil
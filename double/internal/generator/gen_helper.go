@@ -1,9 +1,62 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
+	"go/format"
 	"go/types"
+	"os"
+	"strings"
+	"text/template"
 )
 
+// writeGoFile executes the template with the provided data, and writes the formatted result to destFilePath.
+func writeGoFile(tmpl *template.Template, data any, destFilePath string) error {
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("unable to execute template: %w", err)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("unable to format output: %w", err)
+	}
+
+	if err := os.WriteFile(destFilePath, source, 0o644); err != nil { //nolint:gosec // generated sources are meant to be readable by everyone
+		return fmt.Errorf("unable to write output file: %w", err)
+	}
+
+	return nil
+}
+
+// importsDeclaration returns the import declaration of the provided packages, grouped like goimports
+// does with the local packages being the ones of this module: standard library, third parties, then local packages.
+func importsDeclaration(imports []string) string {
+	var groups [3][]string
+
+	for _, path := range imports {
+		switch {
+		case strings.HasPrefix(path, "github.com/krostar/cli"):
+			groups[2] = append(groups[2], path)
+		case strings.Contains(strings.Split(path, "/")[0], "."):
+			groups[1] = append(groups[1], path)
+		default:
+			groups[0] = append(groups[0], path)
+		}
+	}
+
+	var blocks []string
+
+	for _, group := range groups {
+		if len(group) > 0 {
+			blocks = append(blocks, "\t\""+strings.Join(group, "\"\n\t\"")+"\"")
+		}
+	}
+
+	return "import (\n" + strings.Join(blocks, "\n\n") + "\n)"
+}
+
 // getTupleRepresentation transforms a types.Tuple (representing function parameters or results)
 // into three parallel slices: variable names, type names, and "variable type" strings.
 //
@@ -39,30 +92,6 @@ func getTupleRepresentation(params *types.Tuple, imports map[string]string, nbVa
 	return vars, typs, varsTypes
 }
 
-// generateCombinations creates all possible non-empty subsets of the provided set.
-// Adapted from: https://github.com/mxschmitt/golang-combinations/blob/main/combinations.go
-func generateCombinations[T any](set []T) [][]T {
-	var combinations [][]T
-
-	length := uint(len(set))
-
-	// Go through all possible combinations of objects
-	// from 1 (only first object in subset) to 2^length-1 (all objects in subset)
-	for subsetBits := 1; subsetBits < (1 << length); subsetBits++ {
-		var subset []T
-
-		for object := range length {
-			if (subsetBits>>object)&1 == 1 {
-				subset = append(subset, set[object])
-			}
-		}
-
-		combinations = append(combinations, subset)
-	}
-
-	return combinations
-}
-
 // fillUsedImports recursively analyzes a type to identify all packages that need to be imported.
 // It modifies the provided imports map to include all required packages.
 //
@@ -1,12 +1,9 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"go/types"
 	"maps"
-	"os"
-	"os/exec"
 	"slices"
 	"strings"
 	"text/template"
@@ -26,19 +23,16 @@ type templateSpyDataMethod struct {
 	OutputTypes    []string
 }
 
-func generateSpyImplementation(ctx context.Context, mainCommandInterface *types.Interface, otherCommandInterfaces map[string]*types.Interface, destFilePath string) error {
+func generateSpyImplementation(mainCommandInterface *types.Interface, otherCommandInterfaces map[string]*types.Interface, destFilePath string) error {
 	mainCommandName := "Command"
 	tmpl := template.Must(template.New("spy").Funcs(template.FuncMap{
+		"imports": importsDeclaration,
 		"strjoin": strings.Join,
 	}).Parse(`// Code generated by double/internal/generator DO NOT EDIT.
 
 package double
 
-import (
-{{- range .Imports }}
-	"{{ . }}"
-{{- end }}
-)
+{{ imports .Imports }}
 
 // spyAllInterfaces implements all CLI command interfaces while capturing method calls.
 // It wraps an underlying command and intercepts all interface method calls to record them.
@@ -52,8 +46,11 @@ type spyAllInterfaces struct {
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
 func (spy *spyAllInterfaces) {{ $method.Name }}({{ strjoin $method.InputVarsTypes ", " }}){{ if gt (len $method.OutputTypes) 1 }} ({{- end }} {{ strjoin $method.OutputTypes ", " }}{{ if gt (len $method.OutputTypes) 1 -}} ) {{- end }} {
+	{{ if ne $method.Implements "cli.Command" -}}
+	underlying, _ := cli.AsCommand[{{ $method.Implements }}](spy.underlying)
+	{{ end -}}
 	{{ if $method.OutputTypes -}}{{ strjoin $method.OutputVars ", " }} := {{ end -}}
-	spy.underlying.({{ $method.Implements }}).{{ $method.Name }}({{ strjoin $method.InputVars ", " }})
+	{{ if ne $method.Implements "cli.Command" }}underlying{{ else }}spy.underlying{{ end }}.{{ $method.Name }}({{ strjoin $method.InputVars ", " }})
 
 	spy.saveRecord(SpyCommandRecord{
 		Method:  "{{ $method.Name }}",
@@ -75,8 +72,8 @@ func (spy *spyAllInterfaces) {{ $method.Name }}({{ strjoin $method.InputVarsType
 	imports := make(map[string]string)
 	imports["github.com/krostar/cli"] = "cli"
 
-	for name, iface := range allInterfaces {
-		for method := range iface.Methods() {
+	for _, name := range slices.Sorted(maps.Keys(allInterfaces)) {
+		for method := range allInterfaces[name].Methods() {
 			sig := method.Signature()
 
 			inputVars, _, inputVarsTypes := getTupleRepresentation(sig.Params(), imports, 0)
@@ -95,18 +92,8 @@ func (spy *spyAllInterfaces) {{ $method.Name }}({{ strjoin $method.InputVarsType
 
 	data.Imports = slices.Sorted(maps.Keys(imports))
 
-	file, err := os.Create(destFilePath)
-	if err != nil {
-		return fmt.Errorf("unable to open output file: %w", err)
-	}
-	defer file.Close()
-
-	if err := tmpl.Execute(file, data); err != nil {
-		return fmt.Errorf("unable to execute template: %w", err)
-	}
-
-	if err := exec.CommandContext(ctx, "just", "fmt", destFilePath).Run(); err != nil {
-		return fmt.Errorf("unable to format output: %w", err)
+	if err := writeGoFile(tmpl, data, destFilePath); err != nil {
+		return fmt.Errorf("unable to write spy: %w", err)
 	}
 
 	return nil
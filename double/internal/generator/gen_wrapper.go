@@ -1,212 +1,165 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"go/types"
 	"maps"
-	"os"
-	"os/exec"
 	"slices"
 	"strings"
 	"text/template"
 )
 
 type templateWrapperData struct {
-	Imports       []string
-	AllInterfaces []string
-	Switch        templateWrapperDataSwitch
-	Types         []templateWrapperDataType
+	Imports    []string
+	Execute    templateWrapperDataMethod
+	Interfaces []templateWrapperDataInterface
 }
 
-type templateWrapperDataSwitch struct {
-	Interfaces map[string]string
-	Cases      []templateWrapperDataSwitchCase
+type templateWrapperDataInterface struct {
+	Name    string
+	OK      string
+	Type    string
+	Methods []templateWrapperDataMethod
 }
 
-type templateWrapperDataSwitchCase struct {
-	Condition  string
-	ReturnImpl string
-}
-
-type templateWrapperDataType struct {
-	Name       string
-	Interfaces []string
-	Methods    []templateWrapperDataTypeMethod
-}
-
-type templateWrapperDataTypeMethod struct {
-	ReceiverName string
+type templateWrapperDataMethod struct {
 	Name         string
 	Inputs       string
 	Outputs      string
 	ReturnParams string
 }
 
-func generateInterfaceWrappedImplementation(ctx context.Context, mainCommandInterface *types.Interface, otherCommandInterfaces map[string]*types.Interface, destFilePath string) error {
-	mainCommandName := "Command"
+func generateInterfaceWrappedImplementation(mainCommandInterface *types.Interface, otherCommandInterfaces map[string]*types.Interface, destFilePath string) error {
 	tmpl := template.Must(template.New("wrapper").Funcs(template.FuncMap{
-		"mapvalues": func(m map[string]string) []string {
-			var values []string
-			for _, value := range m {
-				values = append(values, value)
-			}
-			slices.Sort(values)
-			return values
-		},
-		"strjoin": strings.Join,
+		"imports": importsDeclaration,
 	}).Parse(`// Code generated by double/internal/generator DO NOT EDIT.
 
 package double
 
-import (
-{{- range .Imports }}
-	"{{ . }}"
-{{- end }}
-)
+{{ imports .Imports }}
 
 // commandAllInterfaces is a composite interface that includes all CLI command interfaces.
 type commandAllInterfaces interface {
-{{ range .AllInterfaces}}	{{ . }}
-{{ end -}}
+	cli.Command
+{{- range .Interfaces }}
+	cli.{{ .Name }}
+{{- end }}
 }
 
 // reduceWrappedToUnderlyingInterface examines which interfaces the underlying command
-// implements and returns a wrapper that implements the same interfaces.
+// provides and returns a wrapper that provides the same interfaces.
 // This ensures that the resulting wrapper preserves the exact interface set
 // of the original command.
 func reduceWrappedToUnderlyingInterface(underlying cli.Command, wrapped commandAllInterfaces) cli.Command {
-	var (
-		{{- range $name, $interface := .Switch.Interfaces }}
-		_, {{ $interface }} = underlying.(cli.{{ $name }})
-		{{- end }}
-	)
+	cmd := &wrappedCommand{wrapped: wrapped}
+{{- range .Interfaces }}
+	_, cmd.{{ .OK }} = cli.AsCommand[cli.{{ .Name }}](underlying)
+{{- end }}
 
-	return reduceWrapped(wrapped, {{ strjoin (mapvalues .Switch.Interfaces) ", " }})
+	return cmd
 }
 
-// reduceWrapped returns a command implementation that exposes only the specified interfaces.
-func reduceWrapped(wrapped commandAllInterfaces, {{ strjoin (mapvalues .Switch.Interfaces) ", " }} bool) cli.Command {
-	switch {
-	{{- range .Switch.Cases}}
-	case {{.Condition}}:
-		return &{{ .ReturnImpl }}{ wrapped: wrapped }
-	{{- end }}
-	default:
-		panic("this should never happen")
+// reduceWrapped returns a command implementation that provides only the specified interfaces.
+func reduceWrapped(wrapped commandAllInterfaces{{ range .Interfaces }}, {{ .OK }}{{ end }} bool) cli.Command {
+	return &wrappedCommand{
+		wrapped: wrapped,
+{{- range .Interfaces }}
+		{{ .OK }}: {{ .OK }},
+{{- end }}
 	}
 }
-{{ range $type := .Types }}
-type {{ $type.Name }} struct { wrapped commandAllInterfaces }
-{{ range $idx, $method := .Methods }}
-func ({{ $method.ReceiverName }} *{{ $type.Name }}) {{ $method.Name }}{{ $method.Inputs }} {{ $method.Outputs }} { {{- if $method.Outputs }} return {{ $method.ReceiverName }}.wrapped.{{ $method.Name }}({{ $method.ReturnParams }}) {{- end }} } {{ end }}
-{{ end }}`))
 
-	var data templateWrapperData
+// wrappedCommand implements cli.Command by calling the wrapped command, and provides
+// the other command interfaces the wrapped command is allowed to implement through cli.CommandWrapper.
+type wrappedCommand struct {
+	wrapped commandAllInterfaces
+{{- range .Interfaces }}
+	{{ .OK }} bool
+{{- end }}
+}
 
-	otherCommandInterfaceNames := slices.Sorted(maps.Keys(otherCommandInterfaces))
-	otherCommandInterfaceNamesComb := generateCombinations(otherCommandInterfaceNames)
+func (r *wrappedCommand) {{ .Execute.Name }}{{ .Execute.Inputs }} {{ .Execute.Outputs }} {
+	return r.wrapped.{{ .Execute.Name }}({{ .Execute.ReturnParams }})
+}
 
-	allInterfaces := maps.Clone(otherCommandInterfaces)
-	allInterfaces[mainCommandName] = mainCommandInterface
+// As implements cli.CommandWrapper.
+func (r *wrappedCommand) As(target any) bool {
+	switch target := target.(type) {
+{{- range .Interfaces }}
+	case *cli.{{ .Name }}:
+		if r.{{ .OK }} {
+			*target = {{ .Type }}{wrapped: r.wrapped}
+		}
 
-	for name := range allInterfaces {
-		data.AllInterfaces = append(data.AllInterfaces, "cli."+name)
+		return r.{{ .OK }}
+{{- end }}
+	default:
+		return false
 	}
+}
+{{ range $iface := .Interfaces }}
+type {{ $iface.Type }} struct{ wrapped commandAllInterfaces }
+{{ range $iface.Methods }}
+func (r {{ $iface.Type }}) {{ .Name }}{{ .Inputs }} {{ .Outputs }} {
+	{{ if .Outputs }}return {{ end }}r.wrapped.{{ .Name }}({{ .ReturnParams }})
+}
+{{ end }}
+{{- end }}`))
 
-	slices.Sort(data.AllInterfaces)
-
-	{ // generate the create impl func
-		conditionsToClone := make(map[string]bool, len(otherCommandInterfaceNames))
-		data.Switch.Interfaces = make(map[string]string, len(otherCommandInterfaceNames))
+	imports := map[string]string{"github.com/krostar/cli": "cli"}
 
-		for _, name := range otherCommandInterfaceNames {
-			conditionsToClone[name] = false
-			data.Switch.Interfaces[name] = "ok" + name
-		}
+	var data templateWrapperData
 
-		for _, combinations := range append(otherCommandInterfaceNamesComb, []string{}) {
-			conditions := maps.Clone(conditionsToClone)
-			for _, iface := range combinations {
-				conditions[iface] = true
-			}
+	data.Execute = newTemplateWrapperDataMethods(mainCommandInterface, imports)[0]
 
-			var rawSwitchConditions []string
+	for _, name := range slices.Sorted(maps.Keys(otherCommandInterfaces)) {
+		data.Interfaces = append(data.Interfaces, templateWrapperDataInterface{
+			Name:    name,
+			OK:      "ok" + strings.TrimPrefix(name, "Command"),
+			Type:    "wrapped" + name,
+			Methods: newTemplateWrapperDataMethods(otherCommandInterfaces[name], imports),
+		})
+	}
 
-			for name, cond := range conditions {
-				if cond {
-					rawSwitchConditions = append(rawSwitchConditions, data.Switch.Interfaces[name])
-				} else {
-					rawSwitchConditions = append(rawSwitchConditions, "!"+data.Switch.Interfaces[name])
-				}
-			}
+	data.Imports = slices.Sorted(maps.Keys(imports))
 
-			data.Switch.Cases = append(data.Switch.Cases, templateWrapperDataSwitchCase{
-				Condition:  strings.Join(rawSwitchConditions, " && "),
-				ReturnImpl: "wrappedCommand" + strings.Join(combinations, ""),
-			})
-		}
+	if err := writeGoFile(tmpl, data, destFilePath); err != nil {
+		return fmt.Errorf("unable to write wrapper: %w", err)
 	}
 
-	{ // generate the types
-		imports := make(map[string]string)
-
-		for _, comb := range append([][]string{{}}, otherCommandInterfaceNamesComb...) {
-			var (
-				ifaces  []string
-				methods []templateWrapperDataTypeMethod
-			)
-
-			for _, iface := range append([]string{mainCommandName}, comb...) {
-				ifaces = append(ifaces, "cli."+iface)
-				for method := range allInterfaces[iface].Methods() {
-					sig := method.Signature()
-
-					inputVars, _, inputVarsTypes := getTupleRepresentation(sig.Params(), imports, 0)
-					_, outputTypes, _ := getTupleRepresentation(sig.Results(), imports, len(inputVars))
-
-					methods = append(methods, templateWrapperDataTypeMethod{
-						ReceiverName: "r",
-						Name:         method.Name(),
-						Inputs:       "(" + strings.Join(inputVarsTypes, ", ") + ")",
-						Outputs:      strings.Join(outputTypes, ","),
-						ReturnParams: strings.Join(inputVars, ", "),
-					})
-				}
-			}
+	return nil
+}
 
-			data.Imports = slices.Sorted(maps.Keys(imports))
-			data.Types = append(data.Types, templateWrapperDataType{
-				Name:       "wrappedCommand" + strings.Join(comb, ""),
-				Interfaces: ifaces,
-				Methods:    methods,
-			})
-		}
-	}
+func newTemplateWrapperDataMethods(iface *types.Interface, imports map[string]string) []templateWrapperDataMethod {
+	var methods []templateWrapperDataMethod
 
-	file, err := os.Create(destFilePath)
-	if err != nil {
-		return fmt.Errorf("unable to open output file: %w", err)
-	}
-	defer file.Close()
+	for method := range iface.Methods() {
+		sig := method.Signature()
 
-	if err := tmpl.Execute(file, data); err != nil {
-		return fmt.Errorf("unable to execute template: %w", err)
-	}
+		inputVars, _, inputVarsTypes := getTupleRepresentation(sig.Params(), imports, 0)
+		_, outputTypes, _ := getTupleRepresentation(sig.Results(), imports, len(inputVars))
 
-	if err := exec.CommandContext(ctx, "just", "fmt", destFilePath).Run(); err != nil {
-		return fmt.Errorf("unable to format output: %w", err)
+		outputs := strings.Join(outputTypes, ", ")
+		if len(outputTypes) > 1 {
+			outputs = "(" + outputs + ")"
+		}
+
+		methods = append(methods, templateWrapperDataMethod{
+			Name:         method.Name(),
+			Inputs:       "(" + strings.Join(inputVarsTypes, ", ") + ")",
+			Outputs:      outputs,
+			ReturnParams: strings.Join(inputVars, ", "),
+		})
 	}
 
-	return nil
+	return methods
 }
 
 type templateTestWrapperData struct {
-	Imports       []string
-	AllInterfaces []string
+	Interfaces []string
 }
 
-func generateInterfaceWrappedTestImplementation(ctx context.Context, _ *types.Interface, otherCommandInterfaces map[string]*types.Interface, destFilePath string) error {
+func generateInterfaceWrappedTestImplementation(_ *types.Interface, otherCommandInterfaces map[string]*types.Interface, destFilePath string) error {
 	tmpl := template.Must(template.New("wrapper").Parse(`// Code generated by double/internal/generator DO NOT EDIT.
 
 package double
@@ -214,14 +167,16 @@ package double
 import (
 	"testing"
 
-	"github.com/krostar/cli"
 	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
 )
 
 func Test_reduceWrapped(t *testing.T) {
 	allInterfaces := []string{
-		{{ range .AllInterfaces -}} "{{ . }}",
-		{{ end -}}
+{{- range .Interfaces }}
+		"{{ . }}",
+{{- end }}
 	}
 
 	combinationsCount := 1 << len(allInterfaces)
@@ -238,51 +193,38 @@ func Test_reduceWrapped(t *testing.T) {
 		}
 	}
 
-	checkInterfaceImplements := map[string]func(*testing.T, cli.Command) bool{
-	{{- range .AllInterfaces }}
-		"{{ . }}": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.{{ . }})
-			return ok
+	checkInterfaceImplements := map[string]func(cli.Command) (bool, bool){
+{{- range .Interfaces }}
+		"{{ . }}": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.{{ . }})
+			_, ok := cli.AsCommand[cli.{{ . }}](cmd)
+			return direct, ok
 		},
-	{{- end }}
+{{- end }}
 	}
 
 	fake := new(fakeAllInterfaces)
 
-	for i := 0; i < len(combinations); i++ {
+	for i := range combinations {
 		cmd := reduceWrapped(fake,
-		{{- range $idx, $name := .AllInterfaces }}
+{{- range $idx, $name := .Interfaces }}
 			combinations[i][{{ $idx }}],
-		{{- end }}
+{{- end }}
 		)
 
 		for j, shouldImplement := range combinations[i] {
-			implements := checkInterfaceImplements[allInterfaces[j]](t, cmd)
+			direct, implements := checkInterfaceImplements[allInterfaces[j]](cmd)
+			test.Assert(t, !direct, "%s should only be provided through cli.CommandWrapper", allInterfaces[j])
 			test.Assert(t, implements == shouldImplement, "%s implemented/should be: %t/%t", allInterfaces[j], implements, shouldImplement)
 		}
 	}
 }
 `))
 
-	var data templateTestWrapperData
-	for name := range otherCommandInterfaces {
-		data.AllInterfaces = append(data.AllInterfaces, name)
-	}
-
-	slices.Sort(data.AllInterfaces)
-
-	file, err := os.Create(destFilePath)
-	if err != nil {
-		return fmt.Errorf("unable to open output file: %w", err)
-	}
-	defer file.Close()
-
-	if err := tmpl.Execute(file, data); err != nil {
-		return fmt.Errorf("unable to execute template: %w", err)
-	}
+	data := templateTestWrapperData{Interfaces: slices.Sorted(maps.Keys(otherCommandInterfaces))}
 
-	if err := exec.CommandContext(ctx, "just", "fmt", destFilePath).Run(); err != nil {
-		return fmt.Errorf("unable to format output: %w", err)
+	if err := writeGoFile(tmpl, data, destFilePath); err != nil {
+		return fmt.Errorf("unable to write wrapper tests: %w", err)
 	}
 
 	return nil
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"go/ast"
 	"go/importer"
@@ -20,28 +19,26 @@ func main() {
 
 	cliPath := os.Args[1]
 
-	ctx := context.Background()
-
 	command, others, err := findCommandInterfaces(cliPath)
 	if err != nil {
 		panic(fmt.Sprintf("unable to find all Command* interfaces: %v", err))
 	}
 
 	{
-		if err := generateInterfaceWrappedImplementation(ctx, command, others, filepath.Join(cliPath, "double", "wrapper_generated.go")); err != nil {
+		if err := generateInterfaceWrappedImplementation(command, others, filepath.Join(cliPath, "double", "wrapper_generated.go")); err != nil {
 			panic(fmt.Errorf("failed to generate wrapper: %v", err))
 		}
 
-		if err := generateInterfaceWrappedTestImplementation(ctx, command, others, filepath.Join(cliPath, "double", "wrapper_generated_test.go")); err != nil {
+		if err := generateInterfaceWrappedTestImplementation(command, others, filepath.Join(cliPath, "double", "wrapper_generated_test.go")); err != nil {
 			panic(fmt.Errorf("failed to generate wrapper tests: %v", err))
 		}
 	}
 
-	if err := generateFakeImplementation(ctx, command, others, filepath.Join(cliPath, "double", "fake_generated.go")); err != nil {
+	if err := generateFakeImplementation(command, others, filepath.Join(cliPath, "double", "fake_generated.go")); err != nil {
 		panic(fmt.Errorf("failed to generate fake: %v", err))
 	}
 
-	if err := generateSpyImplementation(ctx, command, others, filepath.Join(cliPath, "double", "spy_generated.go")); err != nil {
+	if err := generateSpyImplementation(command, others, filepath.Join(cliPath, "double", "spy_generated.go")); err != nil {
 		panic(fmt.Errorf("failed to generate spy: %v", err))
 	}
 }
@@ -92,7 +89,7 @@ func findCommandInterfaces(dir string) (*types.Interface, map[string]*types.Inte
 	otherInterfaces := make(map[string]*types.Interface)
 
 	for _, name := range pkg.Scope().Names() {
-		if !strings.HasPrefix(name, "Command") {
+		if !strings.HasPrefix(name, "Command") || name == "CommandWrapper" {
 			continue
 		}
 
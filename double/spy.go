@@ -1,5 +1,7 @@
 package double
 
+//go:generate go run ./internal/generator ..
+
 import (
 	"container/list"
 	"strings"
@@ -13,7 +15,9 @@ import (
 // SpyCLI creates a spy wrapper around a CLI instance to track method calls.
 // It returns both the spy object for assertions and the wrapped CLI for use in tests.
 // The spy records all method calls made on any command in the CLI tree, allowing
-// verification of call sequences and parameters.
+// verification of call sequences and parameters. Like the commands created by NewFake,
+// spied commands provide the optional command interfaces of the commands they wrap
+// through cli.CommandWrapper: use cli.AsCommand to get them.
 func SpyCLI(c *cli.CLI) (*Spy, *cli.CLI) {
 	spy := &Spy{commands: make(map[*list.Element][]*cli.CLI)}
 	return spy, wrapCLIWithSpy(spy, nil, c)
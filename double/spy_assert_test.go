@@ -21,7 +21,7 @@ func Test_Spy_AssertCommandMethodCalled(t *testing.T) {
 		spiedT.ExpectLogsToContain(t, "count is less than 1", "expected test method Description to be called at least once but was not called")
 	})
 
-	spiedCLI.Command.(cli.CommandDescription).Description()
+	provided[cli.CommandDescription](spiedCLI.Command).Description()
 
 	t.Run("called once", func(t *testing.T) {
 		spiedT := testdouble.NewSpy(testdouble.NewFake())
@@ -31,7 +31,7 @@ func Test_Spy_AssertCommandMethodCalled(t *testing.T) {
 		spiedT.ExpectTestToPass(t)
 	})
 
-	spiedCLI.Command.(cli.CommandDescription).Description()
+	provided[cli.CommandDescription](spiedCLI.Command).Description()
 
 	t.Run("at least once", func(t *testing.T) {
 		spiedT := testdouble.NewSpy(testdouble.NewFake())
@@ -53,10 +53,10 @@ func Test_Spy_AssertCommandMethodSequence(t *testing.T) {
 	spiedCLI.Name = "test"
 
 	cmd := spiedCLI.Command
-	cmd.(cli.CommandDescription).Description()
-	cmd.(cli.CommandUsage).Usage()
-	cmd.(cli.CommandExamples).Examples()
-	cmd.(cli.CommandExamples).Examples()
+	provided[cli.CommandDescription](cmd).Description()
+	provided[cli.CommandUsage](cmd).Usage()
+	provided[cli.CommandExamples](cmd).Examples()
+	provided[cli.CommandExamples](cmd).Examples()
 
 	t.Run("correct complete sequence", func(t *testing.T) {
 		spiedT := testdouble.NewSpy(testdouble.NewFake())
@@ -87,9 +87,9 @@ func Test_Spy_AssertCommandRecords(t *testing.T) {
 	spiedCLI.Name = "test"
 
 	cmd := spiedCLI.Command
-	cmd.(cli.CommandDescription).Description()
-	cmd.(cli.CommandDescription).Description()
-	cmd.(cli.CommandUsage).Usage()
+	provided[cli.CommandDescription](cmd).Description()
+	provided[cli.CommandDescription](cmd).Description()
+	provided[cli.CommandUsage](cmd).Usage()
 
 	t.Run("check passed", func(t *testing.T) {
 		spiedT := testdouble.NewSpy(testdouble.NewFake())
@@ -16,11 +16,75 @@ type spyAllInterfaces struct {
 	saveRecord func(record SpyCommandRecord) // Function to save records of method calls
 }
 
+// Execute implements the cli.Command interface.
+// It forwards the call to the underlying command while recording the method call,
+// its parameters, and the return values.
+func (spy *spyAllInterfaces) Execute(a0 context.Context, b0 []string, c0 []string) error {
+	d0 := spy.underlying.Execute(a0, b0, c0)
+
+	spy.saveRecord(SpyCommandRecord{
+		Method:  "Execute",
+		Inputs:  []any{a0, b0, c0},
+		Outputs: []any{d0},
+	})
+
+	return d0
+}
+
+// Aliases implements the cli.CommandAliases interface.
+// It forwards the call to the underlying command while recording the method call,
+// its parameters, and the return values.
+func (spy *spyAllInterfaces) Aliases() []string {
+	underlying, _ := cli.AsCommand[cli.CommandAliases](spy.underlying)
+	a0 := underlying.Aliases()
+
+	spy.saveRecord(SpyCommandRecord{
+		Method:  "Aliases",
+		Inputs:  []any{},
+		Outputs: []any{a0},
+	})
+
+	return a0
+}
+
+// Args implements the cli.CommandArgs interface.
+// It forwards the call to the underlying command while recording the method call,
+// its parameters, and the return values.
+func (spy *spyAllInterfaces) Args() *cli.ArgsSpec {
+	underlying, _ := cli.AsCommand[cli.CommandArgs](spy.underlying)
+	a0 := underlying.Args()
+
+	spy.saveRecord(SpyCommandRecord{
+		Method:  "Args",
+		Inputs:  []any{},
+		Outputs: []any{a0},
+	})
+
+	return a0
+}
+
+// Complete implements the cli.CommandCompletion interface.
+// It forwards the call to the underlying command while recording the method call,
+// its parameters, and the return values.
+func (spy *spyAllInterfaces) Complete(a0 context.Context, b0 []string, c0 string) ([]string, error) {
+	underlying, _ := cli.AsCommand[cli.CommandCompletion](spy.underlying)
+	d0, e0 := underlying.Complete(a0, b0, c0)
+
+	spy.saveRecord(SpyCommandRecord{
+		Method:  "Complete",
+		Inputs:  []any{a0, b0, c0},
+		Outputs: []any{d0, e0},
+	})
+
+	return d0, e0
+}
+
 // Context implements the cli.CommandContext interface.
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
 func (spy *spyAllInterfaces) Context(a0 context.Context) context.Context {
-	b0 := spy.underlying.(cli.CommandContext).Context(a0)
+	underlying, _ := cli.AsCommand[cli.CommandContext](spy.underlying)
+	b0 := underlying.Context(a0)
 
 	spy.saveRecord(SpyCommandRecord{
 		Method:  "Context",
@@ -31,11 +95,28 @@ func (spy *spyAllInterfaces) Context(a0 context.Context) context.Context {
 	return b0
 }
 
+// Deprecation implements the cli.CommandDeprecated interface.
+// It forwards the call to the underlying command while recording the method call,
+// its parameters, and the return values.
+func (spy *spyAllInterfaces) Deprecation() string {
+	underlying, _ := cli.AsCommand[cli.CommandDeprecated](spy.underlying)
+	a0 := underlying.Deprecation()
+
+	spy.saveRecord(SpyCommandRecord{
+		Method:  "Deprecation",
+		Inputs:  []any{},
+		Outputs: []any{a0},
+	})
+
+	return a0
+}
+
 // Description implements the cli.CommandDescription interface.
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
 func (spy *spyAllInterfaces) Description() string {
-	a0 := spy.underlying.(cli.CommandDescription).Description()
+	underlying, _ := cli.AsCommand[cli.CommandDescription](spy.underlying)
+	a0 := underlying.Description()
 
 	spy.saveRecord(SpyCommandRecord{
 		Method:  "Description",
@@ -50,7 +131,8 @@ func (spy *spyAllInterfaces) Description() string {
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
 func (spy *spyAllInterfaces) Examples() []string {
-	a0 := spy.underlying.(cli.CommandExamples).Examples()
+	underlying, _ := cli.AsCommand[cli.CommandExamples](spy.underlying)
+	a0 := underlying.Examples()
 
 	spy.saveRecord(SpyCommandRecord{
 		Method:  "Examples",
@@ -61,14 +143,15 @@ func (spy *spyAllInterfaces) Examples() []string {
 	return a0
 }
 
-// Hook implements the cli.CommandHook interface.
+// Flags implements the cli.CommandFlags interface.
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
-func (spy *spyAllInterfaces) Hook() *cli.Hook {
-	a0 := spy.underlying.(cli.CommandHook).Hook()
+func (spy *spyAllInterfaces) Flags() []cli.Flag {
+	underlying, _ := cli.AsCommand[cli.CommandFlags](spy.underlying)
+	a0 := underlying.Flags()
 
 	spy.saveRecord(SpyCommandRecord{
-		Method:  "Hook",
+		Method:  "Flags",
 		Inputs:  []any{},
 		Outputs: []any{a0},
 	})
@@ -76,14 +159,15 @@ func (spy *spyAllInterfaces) Hook() *cli.Hook {
 	return a0
 }
 
-// PersistentFlags implements the cli.CommandPersistentFlags interface.
+// Hidden implements the cli.CommandHidden interface.
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
-func (spy *spyAllInterfaces) PersistentFlags() []cli.Flag {
-	a0 := spy.underlying.(cli.CommandPersistentFlags).PersistentFlags()
+func (spy *spyAllInterfaces) Hidden() bool {
+	underlying, _ := cli.AsCommand[cli.CommandHidden](spy.underlying)
+	a0 := underlying.Hidden()
 
 	spy.saveRecord(SpyCommandRecord{
-		Method:  "PersistentFlags",
+		Method:  "Hidden",
 		Inputs:  []any{},
 		Outputs: []any{a0},
 	})
@@ -91,29 +175,31 @@ func (spy *spyAllInterfaces) PersistentFlags() []cli.Flag {
 	return a0
 }
 
-// Execute implements the cli.Command interface.
+// Hook implements the cli.CommandHook interface.
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
-func (spy *spyAllInterfaces) Execute(a0 context.Context, b0, c0 []string) error {
-	d0 := spy.underlying.(cli.Command).Execute(a0, b0, c0)
+func (spy *spyAllInterfaces) Hook() *cli.Hook {
+	underlying, _ := cli.AsCommand[cli.CommandHook](spy.underlying)
+	a0 := underlying.Hook()
 
 	spy.saveRecord(SpyCommandRecord{
-		Method:  "Execute",
-		Inputs:  []any{a0, b0, c0},
-		Outputs: []any{d0},
+		Method:  "Hook",
+		Inputs:  []any{},
+		Outputs: []any{a0},
 	})
 
-	return d0
+	return a0
 }
 
-// Flags implements the cli.CommandFlags interface.
+// PersistentFlags implements the cli.CommandPersistentFlags interface.
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
-func (spy *spyAllInterfaces) Flags() []cli.Flag {
-	a0 := spy.underlying.(cli.CommandFlags).Flags()
+func (spy *spyAllInterfaces) PersistentFlags() []cli.Flag {
+	underlying, _ := cli.AsCommand[cli.CommandPersistentFlags](spy.underlying)
+	a0 := underlying.PersistentFlags()
 
 	spy.saveRecord(SpyCommandRecord{
-		Method:  "Flags",
+		Method:  "PersistentFlags",
 		Inputs:  []any{},
 		Outputs: []any{a0},
 	})
@@ -125,7 +211,8 @@ func (spy *spyAllInterfaces) Flags() []cli.Flag {
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
 func (spy *spyAllInterfaces) PersistentHook() *cli.PersistentHook {
-	a0 := spy.underlying.(cli.CommandPersistentHook).PersistentHook()
+	underlying, _ := cli.AsCommand[cli.CommandPersistentHook](spy.underlying)
+	a0 := underlying.PersistentHook()
 
 	spy.saveRecord(SpyCommandRecord{
 		Method:  "PersistentHook",
@@ -140,7 +227,8 @@ func (spy *spyAllInterfaces) PersistentHook() *cli.PersistentHook {
 // It forwards the call to the underlying command while recording the method call,
 // its parameters, and the return values.
 func (spy *spyAllInterfaces) Usage() string {
-	a0 := spy.underlying.(cli.CommandUsage).Usage()
+	underlying, _ := cli.AsCommand[cli.CommandUsage](spy.underlying)
+	a0 := underlying.Usage()
 
 	spy.saveRecord(SpyCommandRecord{
 		Method:  "Usage",
@@ -29,15 +29,30 @@ func Test_SpyCLI(t *testing.T) {
 		test.Assert(t, called)
 	})
 
+	t.Run("spy keeps subcommands visibility", func(t *testing.T) {
+		_, spiedCLI := SpyCLI(cli.New(NewFake()).
+			AddCommand("hidden", NewFake(), cli.WithHiddenCommand()).
+			AddCommand("old", NewFake(), cli.WithDeprecatedCommand("use new instead")),
+		)
+
+		test.Require(t, len(spiedCLI.SubCommands) == 2)
+		test.Assert(t, provided[cli.CommandHidden](spiedCLI.SubCommands[0].Command).Hidden())
+		test.Assert(t, provided[cli.CommandDeprecated](spiedCLI.SubCommands[1].Command).Deprecation() == "use new instead")
+
+		_, deprecated := cli.AsCommand[cli.CommandDeprecated](spiedCLI.SubCommands[0].Command)
+		_, hidden := cli.AsCommand[cli.CommandHidden](spiedCLI.SubCommands[1].Command)
+		test.Assert(t, !deprecated && !hidden)
+	})
+
 	t.Run("spy records method calls", func(t *testing.T) {
 		spy, spiedCLI := SpyCLI(cli.New(NewFake(
 			FakeWithDescription(func() string { return "test description" }),
 			FakeWithUsage(func() string { return "test usage" }),
 		)))
 
-		cmdDescription, okDescription := spiedCLI.Command.(cli.CommandDescription)
-		cmdUsage, okUsage := spiedCLI.Command.(cli.CommandUsage)
-		_, okExample := spiedCLI.Command.(cli.CommandExamples)
+		cmdDescription, okDescription := cli.AsCommand[cli.CommandDescription](spiedCLI.Command)
+		cmdUsage, okUsage := cli.AsCommand[cli.CommandUsage](spiedCLI.Command)
+		_, okExample := cli.AsCommand[cli.CommandExamples](spiedCLI.Command)
 		test.Require(t, okDescription && okUsage && !okExample)
 
 		test.Assert(t, cmdDescription.Description() == "test description")
@@ -54,8 +69,8 @@ func Test_Spy_ForEachCommandRecords(t *testing.T) {
 	)))
 
 	cmd := spiedCLI.Command
-	cmd.(cli.CommandDescription).Description()
-	cmd.(cli.CommandUsage).Usage()
+	provided[cli.CommandDescription](cmd).Description()
+	provided[cli.CommandUsage](cmd).Usage()
 
 	var recordedMethods []string
 
@@ -69,7 +84,7 @@ func Test_Spy_ForEachCommandRecords(t *testing.T) {
 func Test_Spy_CountCommandMethodCalls(t *testing.T) {
 	spy, spiedCLI := SpyCLI(cli.New(NewFake(FakeWithDescription(func() string { return "desc" }))))
 
-	cmd := spiedCLI.Command.(cli.CommandDescription)
+	cmd := provided[cli.CommandDescription](spiedCLI.Command)
 	test.Assert(t, spy.CountCommandMethodCalls([]string{spiedCLI.Name}, "Description") == 0)
 	cmd.Description()
 	test.Assert(t, spy.CountCommandMethodCalls([]string{spiedCLI.Name}, "Description") == 1)
@@ -89,3 +104,9 @@ func Test_Spy_DebugMethods(t *testing.T) {
 	spiedT.ExpectTestToPass(t)
 	spiedT.ExpectLogsToContain(t, "[root] Execute called", "[root.sub] Execute called")
 }
+
+// provided returns the implementation of T provided by the command, which is nil if T is not provided.
+func provided[T any](cmd cli.Command) T {
+	t, _ := cli.AsCommand[T](cmd)
+	return t
+}
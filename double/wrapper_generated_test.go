@@ -12,10 +12,15 @@ import (
 
 func Test_reduceWrapped(t *testing.T) {
 	allInterfaces := []string{
+		"CommandAliases",
+		"CommandArgs",
+		"CommandCompletion",
 		"CommandContext",
+		"CommandDeprecated",
 		"CommandDescription",
 		"CommandExamples",
 		"CommandFlags",
+		"CommandHidden",
 		"CommandHook",
 		"CommandPersistentFlags",
 		"CommandPersistentHook",
@@ -36,44 +41,77 @@ func Test_reduceWrapped(t *testing.T) {
 		}
 	}
 
-	checkInterfaceImplements := map[string]func(*testing.T, cli.Command) bool{
-		"CommandContext": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.CommandContext)
-			return ok
+	checkInterfaceImplements := map[string]func(cli.Command) (bool, bool){
+		"CommandAliases": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandAliases)
+			_, ok := cli.AsCommand[cli.CommandAliases](cmd)
+			return direct, ok
 		},
-		"CommandDescription": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.CommandDescription)
-			return ok
+		"CommandArgs": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandArgs)
+			_, ok := cli.AsCommand[cli.CommandArgs](cmd)
+			return direct, ok
 		},
-		"CommandExamples": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.CommandExamples)
-			return ok
+		"CommandCompletion": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandCompletion)
+			_, ok := cli.AsCommand[cli.CommandCompletion](cmd)
+			return direct, ok
 		},
-		"CommandFlags": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.CommandFlags)
-			return ok
+		"CommandContext": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandContext)
+			_, ok := cli.AsCommand[cli.CommandContext](cmd)
+			return direct, ok
 		},
-		"CommandHook": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.CommandHook)
-			return ok
+		"CommandDeprecated": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandDeprecated)
+			_, ok := cli.AsCommand[cli.CommandDeprecated](cmd)
+			return direct, ok
 		},
-		"CommandPersistentFlags": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.CommandPersistentFlags)
-			return ok
+		"CommandDescription": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandDescription)
+			_, ok := cli.AsCommand[cli.CommandDescription](cmd)
+			return direct, ok
 		},
-		"CommandPersistentHook": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.CommandPersistentHook)
-			return ok
+		"CommandExamples": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandExamples)
+			_, ok := cli.AsCommand[cli.CommandExamples](cmd)
+			return direct, ok
 		},
-		"CommandUsage": func(t *testing.T, cmd cli.Command) bool {
-			_, ok := cmd.(cli.CommandUsage)
-			return ok
+		"CommandFlags": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandFlags)
+			_, ok := cli.AsCommand[cli.CommandFlags](cmd)
+			return direct, ok
+		},
+		"CommandHidden": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandHidden)
+			_, ok := cli.AsCommand[cli.CommandHidden](cmd)
+			return direct, ok
+		},
+		"CommandHook": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandHook)
+			_, ok := cli.AsCommand[cli.CommandHook](cmd)
+			return direct, ok
+		},
+		"CommandPersistentFlags": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandPersistentFlags)
+			_, ok := cli.AsCommand[cli.CommandPersistentFlags](cmd)
+			return direct, ok
+		},
+		"CommandPersistentHook": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandPersistentHook)
+			_, ok := cli.AsCommand[cli.CommandPersistentHook](cmd)
+			return direct, ok
+		},
+		"CommandUsage": func(cmd cli.Command) (bool, bool) {
+			_, direct := cmd.(cli.CommandUsage)
+			_, ok := cli.AsCommand[cli.CommandUsage](cmd)
+			return direct, ok
 		},
 	}
 
 	fake := new(fakeAllInterfaces)
 
-	for i := 0; i < len(combinations); i++ {
+	for i := range combinations {
 		cmd := reduceWrapped(fake,
 			combinations[i][0],
 			combinations[i][1],
@@ -83,10 +121,16 @@ func Test_reduceWrapped(t *testing.T) {
 			combinations[i][5],
 			combinations[i][6],
 			combinations[i][7],
+			combinations[i][8],
+			combinations[i][9],
+			combinations[i][10],
+			combinations[i][11],
+			combinations[i][12],
 		)
 
 		for j, shouldImplement := range combinations[i] {
-			implements := checkInterfaceImplements[allInterfaces[j]](t, cmd)
+			direct, implements := checkInterfaceImplements[allInterfaces[j]](cmd)
+			test.Assert(t, !direct, "%s should only be provided through cli.CommandWrapper", allInterfaces[j])
 			test.Assert(t, implements == shouldImplement, "%s implemented/should be: %t/%t", allInterfaces[j], implements, shouldImplement)
 		}
 	}
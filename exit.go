@@ -13,7 +13,7 @@ import (
 func Exit(ctx context.Context, err error, options ...ExitOption) {
 	o := exitOptions{
 		exitFunc:      os.Exit,
-		getLoggerFunc: getExitLoggerFromMetadata,
+		getLoggerFunc: GetExitLoggerFromMetadata,
 	}
 
 	for _, option := range options {
@@ -80,7 +80,9 @@ func SetExitLoggerInMetadata(ctx context.Context, writer io.WriteCloser) {
 	SetMetadataInContext(ctx, metadataKeyExitLogger, writer)
 }
 
-func getExitLoggerFromMetadata(ctx context.Context) io.WriteCloser {
+// GetExitLoggerFromMetadata returns the logger used by default by the Exit func, set
+// with SetExitLoggerInMetadata, or os.Stderr if none is set. Mappers use it to write warnings.
+func GetExitLoggerFromMetadata(ctx context.Context) io.WriteCloser {
 	rawWriter := GetMetadataFromContext(ctx, metadataKeyExitLogger)
 	if writer, ok := rawWriter.(io.WriteCloser); ok {
 		return writer
@@ -97,19 +97,19 @@ func Test_ExitOption(t *testing.T) {
 	WithExitFunc(os.Exit)(o)
 	test.Require(t, o.exitFunc != nil)
 
-	WithExitLoggerFunc(getExitLoggerFromMetadata)(o)
+	WithExitLoggerFunc(GetExitLoggerFromMetadata)(o)
 	test.Require(t, o.getLoggerFunc != nil)
 }
 
 func Test_loggerInMetadata(t *testing.T) {
 	t.Run("get a logger even if none is previously set", func(t *testing.T) {
-		test.Require(t, getExitLoggerFromMetadata(test.Context(t)) != nil)
+		test.Require(t, GetExitLoggerFromMetadata(test.Context(t)) != nil)
 	})
 
 	t.Run("set a logger", func(t *testing.T) {
 		ctx := NewContextWithMetadata(test.Context(t))
 		logger := new(bufferThatCloses)
 		SetExitLoggerInMetadata(ctx, logger)
-		test.Assert(t, getExitLoggerFromMetadata(ctx) == logger)
+		test.Assert(t, GetExitLoggerFromMetadata(ctx) == logger)
 	})
 }
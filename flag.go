@@ -1,5 +1,11 @@
 package cli
 
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
 // Flag represents a command-line flag. It combines the FlagValuer interface
 // with methods to access flag metadata (long name, short name, description).
 type Flag interface {
@@ -14,15 +20,98 @@ type Flag interface {
 	Description() string
 }
 
+// FlagRequired can be implemented by flags that must be provided for the command to be executed.
+type FlagRequired interface{ Required() bool }
+
+// FlagHidden can be implemented by flags that must not be listed in help, while remaining usable.
+type FlagHidden interface{ Hidden() bool }
+
+// FlagHelpGroup can be implemented by flags listed in help in a section of their own, along with the flags
+// of the same group. An empty group means the flag is listed with the other flags.
+type FlagHelpGroup interface{ HelpGroup() string }
+
+// FlagDeprecated can be implemented by flags being phased out. The returned message,
+// usually pointing to a replacement, is displayed as a warning when the flag is used.
+// Deprecated flags are not listed in help. An empty message means the flag is not deprecated.
+type FlagDeprecated interface{ Deprecation() string }
+
+// FlagEnv can be implemented by flags whose value can be provided by environment variables.
+// Mappers set flags not provided on the command line from the first of their environment
+// variables being set, once the persistent hooks ran and before the command hook runs.
+type FlagEnv interface {
+	// EnvNames returns the names of the environment variables, by order of precedence.
+	EnvNames() []string
+	// FromEnv sets the flag's value from the value of the named environment variable.
+	FromEnv(name, value string) error
+}
+
+// FlagSourced can be implemented by flags knowing where their value comes from.
+type FlagSourced interface {
+	// Source returns FlagSourceCommandLine if the flag has been provided on the command line,
+	// the name of the environment variable prefixed by a $ if it has been set from the environment,
+	// like "$APP_PORT", or an empty string if the flag is not set.
+	Source() string
+}
+
+// FlagSourceCommandLine is the source of the values of flags provided on the command line, see FlagSourced.
+const FlagSourceCommandLine = "command line"
+
+// FlagWrapper can be implemented by flags wrapping another flag. Mappers look for the
+// optional flag interfaces (like FlagRequired) through the whole chain of wrapped flags.
+type FlagWrapper interface{ Unwrap() Flag }
+
+// FlagOption defines options to customize flags created with NewFlag.
+type FlagOption func(*flagValue)
+
+// WithRequired marks the flag as required. Mappers check that required flags are
+// set once the command hooks ran, and report all the missing ones at once.
+// When configuration is loaded by a hook (see cfg package), a flag whose value is provided
+// by the configuration, and not the zero value, also satisfies the requirement.
+func WithRequired() FlagOption {
+	return func(f *flagValue) { f.required = true }
+}
+
+// WithHidden hides the flag from help, while keeping it usable.
+func WithHidden() FlagOption {
+	return func(f *flagValue) { f.hidden = true }
+}
+
+// WithHelpGroup lists the flag in help in a section named after the provided group, like "Networking",
+// along with the other flags of the group.
+func WithHelpGroup(name string) FlagOption {
+	return func(f *flagValue) { f.helpGroup = name }
+}
+
+// WithDeprecated marks the flag as deprecated: it is hidden from help, and the provided
+// message, like "use --new-flag instead", is displayed as a warning when the flag is used.
+func WithDeprecated(message string) FlagOption {
+	return func(f *flagValue) { f.deprecation = message }
+}
+
+// WithEnv binds the flag to the provided environment variables, by order of precedence:
+// when the flag is not provided on the command line, it is set from the first variable being set.
+// Variables are listed in help, like [$APP_PORT]. Unlike the cfg/source/env configuration source,
+// this does not require the configuration machinery, see the cfg package.
+func WithEnv(names ...string) FlagOption {
+	for _, name := range names {
+		if name == "" {
+			panic("environment variable names must be non-empty")
+		}
+	}
+
+	return func(f *flagValue) { f.envs = append(f.envs, names...) }
+}
+
 // NewFlag creates a new Flag instance.
 //
 //	longName is the long flag name, like --longname ; cannot be empty.
 //	shortName is the short flag name ; usually 1 character, like -s ; can be empty.
 //	valuer provide the way to set value to the destination.
 //	description is a short text explaining the flag ; can be empty.
+//	opts are optional behaviors to apply to the flag.
 //
 // It panics if invalid inputs are provided.
-func NewFlag(longName, shortName string, valuer FlagValuer, description string) Flag {
+func NewFlag(longName, shortName string, valuer FlagValuer, description string, opts ...FlagOption) Flag {
 	if longName == "" && shortName == "" {
 		panic("longName and/or shortName must be non-empty")
 	}
@@ -35,13 +124,43 @@ func NewFlag(longName, shortName string, valuer FlagValuer, description string)
 		panic("a non-nil valuer is required")
 	}
 
-	return &flagValue{
+	flag := &flagValue{
 		FlagValuer: valuer,
 
 		longName:    longName,
 		shortName:   shortName,
 		description: description,
 	}
+
+	for _, opt := range opts {
+		opt(flag)
+	}
+
+	return flag
+}
+
+// GetFlagSource returns where the value of the provided flag comes from, looking through the
+// chain of wrapped flags for a flag implementing FlagSourced. Flags not implementing it are
+// considered provided on the command line when they are set.
+func GetFlagSource(flag Flag) string {
+	for current := flag; current != nil; {
+		if sourced, ok := current.(FlagSourced); ok {
+			return sourced.Source()
+		}
+
+		wrapper, ok := current.(FlagWrapper)
+		if !ok {
+			break
+		}
+
+		current = wrapper.Unwrap()
+	}
+
+	if flag != nil && flag.IsSet() {
+		return FlagSourceCommandLine
+	}
+
+	return ""
 }
 
 type flagValue struct {
@@ -50,8 +169,88 @@ type flagValue struct {
 	longName    string
 	shortName   string
 	description string
+	required    bool
+	hidden      bool
+	helpGroup   string
+	deprecation string
+
+	aliases           []FlagAlias
+	underscoreAliases bool
+
+	file       *fileValue
+	validators []Validator
+
+	envs   []string
+	source string
+
+	complete func(ctx context.Context, toComplete string) ([]string, error)
+}
+
+func (f flagValue) LongName() string        { return f.longName }
+func (f flagValue) ShortName() string       { return f.shortName }
+func (f flagValue) Description() string     { return f.description }
+func (f flagValue) Required() bool          { return f.required }
+func (f flagValue) Hidden() bool            { return f.hidden }
+func (f flagValue) HelpGroup() string       { return f.helpGroup }
+func (f flagValue) Deprecation() string     { return f.deprecation }
+func (f flagValue) Validators() []Validator { return slices.Clone(f.validators) }
+func (f flagValue) EnvNames() []string      { return slices.Clone(f.envs) }
+func (f flagValue) Source() string          { return f.source }
+
+// FromString sets the flag's value, which is read from a file or the standard input
+// if the flag has been created with WithFileValue and the raw value designates one.
+// Errors do not name the flag, mappers do when reporting them.
+func (f *flagValue) FromString(raw string) error {
+	if err := f.fromString(raw); err != nil {
+		return err
+	}
+
+	f.source = FlagSourceCommandLine
+
+	return nil
+}
+
+// FromEnv implements FlagEnv ; values read from the environment are handled like values
+// provided on the command line, they can designate files for flags created with WithFileValue.
+func (f *flagValue) FromEnv(name, value string) error {
+	if err := f.fromString(value); err != nil {
+		return fmt.Errorf("environment variable %s of flag %s: %w", name, f.name(), err)
+	}
+
+	f.source = "$" + name
+
+	return nil
 }
 
-func (f flagValue) LongName() string    { return f.longName }
-func (f flagValue) ShortName() string   { return f.shortName }
-func (f flagValue) Description() string { return f.description }
+// fromString sets the flag's value, see FromString.
+func (f flagValue) fromString(raw string) error {
+	if f.file != nil {
+		value, err := f.file.resolve(raw)
+		if err != nil {
+			return err
+		}
+
+		raw = value
+	}
+
+	return f.FlagValuer.FromString(raw)
+}
+
+// name returns the name to use to refer to the flag in errors, like --long or -s.
+func (f flagValue) name() string {
+	if f.longName != "" {
+		return "--" + f.longName
+	}
+
+	return "-" + f.shortName
+}
+
+// DefaultString implements FlagDefault ; it returns the current value of valuers not implementing it.
+func (f flagValue) DefaultString() string { return valuerDefaultString(f.FlagValuer) }
+
+// ResetToDefault implements FlagDefault ; it does nothing for valuers not implementing it,
+// but the source of the value is always forgotten.
+func (f *flagValue) ResetToDefault() {
+	resetValuerToDefault(f.FlagValuer)
+	f.source = ""
+}
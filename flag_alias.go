@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"slices"
+	"strings"
+)
+
+// FlagAliases can be implemented by flags accepting additional long names, like a previous
+// spelling of a renamed flag. All the names set the same value.
+type FlagAliases interface{ Aliases() []FlagAlias }
+
+// FlagAlias is an additional long name of a flag.
+type FlagAlias struct {
+	// Name is the long name of the alias, like "log-lvl".
+	Name string
+	// Deprecated makes the use of the alias display a warning pointing to the flag name.
+	Deprecated bool
+}
+
+// WithAliases adds long names to the flag. Aliases set the same value as the flag,
+// and are not listed in help.
+//
+// It panics if an alias is empty.
+func WithAliases(names ...string) FlagOption {
+	return func(f *flagValue) { f.addAliases(names, false) }
+}
+
+// WithDeprecatedAliases adds long names to the flag, displaying a warning pointing to the
+// flag name when used. It eases renaming a flag: the previous name remains accepted for a
+// few releases, like cli.NewBuiltinFlag("log-level", "", &level, "", cli.WithDeprecatedAliases("log-lvl")).
+//
+// It panics if an alias is empty.
+func WithDeprecatedAliases(names ...string) FlagOption {
+	return func(f *flagValue) { f.addAliases(names, true) }
+}
+
+// WithUnderscoreAliases makes the flag also accept its long name and its aliases spelled
+// with underscores instead of dashes, like --log_level for --log-level.
+func WithUnderscoreAliases() FlagOption {
+	return func(f *flagValue) { f.underscoreAliases = true }
+}
+
+func (f *flagValue) addAliases(names []string, deprecated bool) {
+	for _, name := range names {
+		if name == "" {
+			panic("flag aliases must be non-empty")
+		}
+
+		f.aliases = append(f.aliases, FlagAlias{Name: name, Deprecated: deprecated})
+	}
+}
+
+// Aliases returns the aliases of the flag, including the underscore spellings if requested.
+func (f flagValue) Aliases() []FlagAlias {
+	aliases := slices.Clone(f.aliases)
+
+	if f.underscoreAliases {
+		for _, alias := range append([]FlagAlias{{Name: f.longName}}, f.aliases...) {
+			underscored := FlagAlias{Name: strings.ReplaceAll(alias.Name, "-", "_"), Deprecated: alias.Deprecated}
+			if underscored.Name != alias.Name && !slices.ContainsFunc(aliases, func(a FlagAlias) bool { return a.Name == underscored.Name }) {
+				aliases = append(aliases, underscored)
+			}
+		}
+	}
+
+	return aliases
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+)
+
+func Test_flagValue_Aliases(t *testing.T) {
+	var dest string
+
+	t.Run("no aliases", func(t *testing.T) {
+		test.Assert(t, len(NewBuiltinFlag("log-level", "", &dest, "").(FlagAliases).Aliases()) == 0)
+	})
+
+	t.Run("aliases", func(t *testing.T) {
+		aliases := NewBuiltinFlag("log-level", "", &dest, "", WithAliases("level"), WithDeprecatedAliases("log-lvl", "loglevel")).(FlagAliases).Aliases()
+		test.Assert(check.Compare(t, aliases, []FlagAlias{
+			{Name: "level"},
+			{Name: "log-lvl", Deprecated: true},
+			{Name: "loglevel", Deprecated: true},
+		}))
+	})
+
+	t.Run("underscore aliases", func(t *testing.T) {
+		aliases := NewBuiltinFlag("log-level", "", &dest, "", WithDeprecatedAliases("log-lvl", "level", "log_level"), WithUnderscoreAliases()).(FlagAliases).Aliases()
+		test.Assert(check.Compare(t, aliases, []FlagAlias{
+			{Name: "log-lvl", Deprecated: true},
+			{Name: "level", Deprecated: true},
+			{Name: "log_level", Deprecated: true},
+			{Name: "log_lvl", Deprecated: true},
+		}))
+	})
+
+	t.Run("empty alias", func(t *testing.T) {
+		test.Assert(check.Panics(t, func() {
+			NewBuiltinFlag("log-level", "", &dest, "", WithAliases(""))
+		}, func(reason any) error {
+			if strings.Contains(reason.(string), "flag aliases must be non-empty") {
+				return nil
+			}
+
+			return errors.New("expected different panic reason")
+		}))
+	})
+}
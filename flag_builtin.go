@@ -3,79 +3,58 @@ package cli
 import (
 	"fmt"
 	"strconv"
-	"strings"
 )
 
 // NewBuiltinFlag creates a Flag for built-in types (int, string, bool, etc.).
 // It handles the conversion between string representations and the underlying
 // Go type. See NewFlag for more details.
-func NewBuiltinFlag[T builtins](longName, shortName string, destination *T, description string) Flag {
+func NewBuiltinFlag[T builtins](longName, shortName string, destination *T, description string, opts ...FlagOption) Flag {
 	return NewFlag(
 		longName, shortName,
 		NewFlagValuer(destination, builtinFromString[T], builtinToString[T]),
-		description,
+		description, opts...,
 	)
 }
 
 // NewBuiltinPointerFlag creates a Flag for pointers to built-in types.
 // See NewBuiltinFlag for more details.
-func NewBuiltinPointerFlag[T builtins](longName, shortName string, destination **T, description string) Flag {
+func NewBuiltinPointerFlag[T builtins](longName, shortName string, destination **T, description string, opts ...FlagOption) Flag {
 	return NewFlag(
 		longName, shortName,
-		NewFlagValuer(destination,
-			func(s string) (*T, error) {
-				b, err := builtinFromString[T](s)
-				if err != nil {
-					return nil, err
-				}
-
-				return &b, nil
-			},
-			func(t *T) string {
-				if t != nil {
-					return builtinToString[T](*t)
-				}
-
-				return "<nil>"
-			},
-		), description,
+		newPointerFlagValuer(destination, builtinFromString[T], builtinToString[T]),
+		description, opts...,
 	)
 }
 
 // NewBuiltinSliceFlag creates a Flag for slices of built-in types.
-// The flag value is expected to be a comma-separated list of values.
-// See NewBuiltinFlag for more details.
-func NewBuiltinSliceFlag[T builtins](longName, shortName string, destination *[]T, description string) Flag {
+// The flag value is expected to be a comma-separated list of values ; the way values
+// are split can be changed with WithSeparator and WithCSV, and WithAppend makes each
+// use of the flag add values instead of replacing them. See NewBuiltinFlag for more details.
+func NewBuiltinSliceFlag[T builtins](longName, shortName string, destination *[]T, description string, opts ...FlagOption) Flag {
 	return NewFlag(
 		longName, shortName,
-		NewFlagValuer(destination,
-			func(raw string) ([]T, error) {
-				rawValues := strings.Split(raw, ",")
-				values := make([]T, len(rawValues))
-
-				for i, rawValue := range rawValues {
-					value, err := builtinFromString[T](strings.TrimSpace(rawValue))
-					if err != nil {
-						return nil, err
-					}
-
-					values[i] = value
-				}
-
-				return values, nil
-			},
-			func(values []T) string {
-				valuesRepr := make([]string, len(values))
-				for i, value := range values {
-					valuesRepr[i] = builtinToString(value)
-				}
+		newSliceFlagValuer(destination, builtinFromString[T], builtinToString[T]),
+		description, opts...,
+	)
+}
 
-				return "[" + strings.Join(valuesRepr, ",") + "]"
-			},
-		), description,
+// NewBuiltinMapFlag creates a Flag for maps of built-in types.
+// The flag value is expected to be a comma-separated list of key=value entries, and
+// the flag can be repeated to add more entries, like --label a=1,b=2 --label c=3.
+// The destination is reset when the flag is first set. See NewBuiltinFlag for more details.
+func NewBuiltinMapFlag[K, V builtins](longName, shortName string, destination *map[K]V, description string, opts ...FlagOption) Flag {
+	return NewFlag(
+		longName, shortName,
+		newMapFlagValuer(destination, builtinFromString[K], builtinFromString[V], builtinToString[K], builtinToString[V]),
+		description, opts...,
 	)
 }
 
+// builtinSliceToString converts a slice of built-in types to its string representation.
+func builtinSliceToString[T builtins](values []T) string {
+	return sliceToString(values, builtinToString[T])
+}
+
 type builtins interface {
 	bool | string | int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | complex64 | complex128
 }
@@ -58,6 +58,54 @@ func Test_NewBuiltinSliceFlag(t *testing.T) {
 	test.Assert(t, flag.String() == "[42,44]")
 }
 
+func Test_NewBuiltinMapFlag(t *testing.T) {
+	value := map[string]int{"default": 1}
+
+	flag := NewBuiltinMapFlag[string, int]("longName", "s", &value, "description")
+	test.Assert(t, flag.LongName() == "longName")
+	test.Assert(t, flag.ShortName() == "s")
+	test.Assert(t, flag.Description() == "description")
+	test.Assert(t, flag.TypeRepr() == "map[string]int")
+	test.Assert(t, flag.String() == "default=1")
+
+	for raw, expectedErr := range map[string]string{
+		"a":       `invalid map entry "a": expected key=value`,
+		"a=1,b":   `invalid map entry "b": expected key=value`,
+		"a=abc":   `invalid map value "abc" for key "a"`,
+		" a = 1,": `invalid map entry "": expected key=value`,
+	} {
+		err := flag.FromString(raw)
+		test.Assert(t, err != nil && strings.Contains(err.Error(), expectedErr), err)
+	}
+
+	test.Assert(t, !flag.IsSet() && len(value) == 1)
+
+	test.Assert(t, flag.FromString(" b = 2 , a=1") == nil)
+	test.Assert(t, len(value) == 2 && value["a"] == 1 && value["b"] == 2)
+	test.Assert(t, flag.String() == "a=1,b=2")
+
+	test.Assert(t, flag.FromString("c=3,a=4") == nil)
+	test.Assert(t, len(value) == 3 && value["a"] == 4 && value["c"] == 3)
+	test.Assert(t, flag.String() == "a=4,b=2,c=3")
+
+	t.Run("string round-trip", func(t *testing.T) {
+		var roundTripped map[string]int
+
+		other := NewBuiltinMapFlag[string, int]("longName", "", &roundTripped, "")
+		test.Require(t, other.FromString(flag.String()) == nil)
+		test.Assert(t, other.String() == flag.String())
+	})
+
+	t.Run("typed keys", func(t *testing.T) {
+		var typed map[int]bool
+
+		flag := NewBuiltinMapFlag[int, bool]("longName", "", &typed, "")
+		test.Assert(t, flag.FromString("a=true") != nil)
+		test.Assert(t, flag.FromString("1=true,2=false") == nil)
+		test.Assert(t, len(typed) == 2 && typed[1] && !typed[2])
+	})
+}
+
 func assertEqualIfBuiltinParsingSucceed[T builtins](t *testing.T, providedRawValue string, expectedValue ...T) error {
 	t.Helper()
 
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+	"slices"
+)
+
+// FlagCompletion can be implemented by flags completing their values in shells.
+// Mappers fall back on the candidates of the flag, see FlagCandidates, and then on files.
+type FlagCompletion interface {
+	// CompletesValues returns whether the flag completes its values.
+	CompletesValues() bool
+
+	// Complete returns the candidates for the value being completed, toComplete being
+	// its beginning ; candidates not starting with toComplete are ignored.
+	Complete(ctx context.Context, toComplete string) ([]string, error)
+}
+
+// WithCompletion makes the flag complete its values in shells using the provided function,
+// which can for example list resources from an API. Files are not completed anymore.
+//
+// Example:
+//
+//	cli.NewBuiltinFlag("cluster", "c", &c.cluster, "Cluster to use", cli.WithCompletion(
+//	    func(ctx context.Context, _ string) ([]string, error) { return c.api.ListClusters(ctx) },
+//	))
+func WithCompletion(complete func(ctx context.Context, toComplete string) ([]string, error)) FlagOption {
+	if complete == nil {
+		panic("complete is nil")
+	}
+
+	return func(f *flagValue) { f.complete = complete }
+}
+
+// WithCompletionValues makes the flag complete its values in shells with the provided ones.
+// Unlike enum flags (see NewEnumFlag), other values are accepted.
+func WithCompletionValues(values ...string) FlagOption {
+	values = slices.Clone(values)
+
+	return WithCompletion(func(context.Context, string) ([]string, error) { return values, nil })
+}
+
+func (f flagValue) CompletesValues() bool { return f.complete != nil }
+
+func (f flagValue) Complete(ctx context.Context, toComplete string) ([]string, error) {
+	if f.complete == nil {
+		return nil, nil
+	}
+
+	return f.complete(ctx, toComplete)
+}
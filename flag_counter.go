@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FlagCounter can be implemented by flags counting the number of times they are provided,
+// like -vvv or --verbose --verbose. Mappers set the FlagCounterIncrement value each time
+// such a flag is provided without value.
+type FlagCounter interface{ Counter() bool }
+
+// FlagCounterIncrement is the value set to counter flags provided without value.
+const FlagCounterIncrement = "+1"
+
+// NewCounterFlag creates a Flag counting the number of times it is provided, usually used
+// for verbosity levels: -vvv, --verbose --verbose --verbose and --verbose=3 all set the
+// destination to 3. Values prefixed by a plus sign, like +2, increment the destination
+// instead of setting it. See NewFlag for more details.
+func NewCounterFlag(longName, shortName string, destination *int, description string, opts ...FlagOption) Flag {
+	return &counterFlag{
+		Flag: NewFlag(
+			longName, shortName,
+			NewFlagValuer(destination,
+				func(raw string) (int, error) {
+					increment, isIncrement := strings.CutPrefix(raw, "+")
+
+					value, err := strconv.Atoi(increment)
+					if err != nil {
+						return 0, fmt.Errorf("invalid count %q: %w", raw, err)
+					}
+
+					if isIncrement {
+						value += *destination
+					}
+
+					return value, nil
+				},
+				strconv.Itoa,
+			), description, opts...,
+		),
+	}
+}
+
+type counterFlag struct{ Flag }
+
+func (counterFlag) TypeRepr() string { return "count" }
+func (counterFlag) Counter() bool    { return true }
+func (f counterFlag) Unwrap() Flag   { return f.Flag }
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/krostar/test"
+)
+
+func Test_NewCounterFlag(t *testing.T) {
+	var dest int
+
+	flag := NewCounterFlag("verbose", "v", &dest, "descr", WithRequired())
+	test.Assert(t, flag.LongName() == "verbose" && flag.ShortName() == "v" && flag.Description() == "descr")
+	test.Assert(t, flag.TypeRepr() == "count")
+	test.Assert(t, flag.(FlagCounter).Counter())
+	test.Assert(t, flag.(FlagWrapper).Unwrap().(FlagRequired).Required())
+
+	t.Run("increment", func(t *testing.T) {
+		test.Require(t, flag.FromString(FlagCounterIncrement) == nil)
+		test.Require(t, flag.FromString(FlagCounterIncrement) == nil)
+		test.Assert(t, dest == 2 && flag.String() == "2" && flag.IsSet())
+
+		test.Require(t, flag.FromString("+3") == nil)
+		test.Assert(t, dest == 5)
+	})
+
+	t.Run("explicit value", func(t *testing.T) {
+		test.Require(t, flag.FromString("3") == nil)
+		test.Assert(t, dest == 3)
+
+		test.Require(t, flag.FromString(FlagCounterIncrement) == nil)
+		test.Assert(t, dest == 4)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		err := flag.FromString("lots")
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == `invalid count "lots": strconv.Atoi: parsing "lots": invalid syntax`, err.Error())
+
+		test.Assert(t, flag.FromString("+") != nil)
+		test.Assert(t, dest == 4)
+	})
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FlagCandidates can be implemented by flags whose values are restricted to a known set,
+// to let mappers list them in help and feed shell completion.
+type FlagCandidates interface{ Candidates() []string }
+
+// NewEnumFlag creates a Flag whose value must be one of the allowed values.
+// Unknown values are rejected with an error suggesting the closest allowed value,
+// and the allowed values are listed in the type representation of the flag.
+// See NewFlag for more details.
+//
+// It panics if no allowed values are provided.
+//
+// Example:
+//
+//	type logLevel string
+//
+//	cli.NewEnumFlag("log-level", "l", &cmd.level, []logLevel{"debug", "info", "warn"}, "Log level")
+func NewEnumFlag[T ~string](longName, shortName string, destination *T, allowed []T, description string, opts ...FlagOption) Flag {
+	candidates := enumCandidates(allowed)
+
+	return &enumFlag{
+		Flag: NewFlag(
+			longName, shortName,
+			NewFlagValuer(destination,
+				func(raw string) (T, error) { return T(raw), checkEnumValue(raw, candidates) },
+				func(value T) string { return string(value) },
+			), description, opts...,
+		),
+		candidates: candidates,
+		typeRepr:   "{" + strings.Join(candidates, "|") + "}",
+	}
+}
+
+// NewEnumSliceFlag creates a Flag for slices whose values must all be one of the allowed values.
+// The flag value is expected to be a comma-separated list of values.
+// See NewEnumFlag for more details.
+func NewEnumSliceFlag[T ~string](longName, shortName string, destination *[]T, allowed []T, description string, opts ...FlagOption) Flag {
+	candidates := enumCandidates(allowed)
+
+	return &enumFlag{
+		Flag: NewFlag(
+			longName, shortName,
+			newSliceFlagValuer(destination,
+				func(raw string) (T, error) { return T(raw), checkEnumValue(raw, candidates) },
+				func(value T) string { return string(value) },
+			), description, opts...,
+		),
+		candidates: candidates,
+		typeRepr:   "[]{" + strings.Join(candidates, "|") + "}",
+	}
+}
+
+type enumFlag struct {
+	Flag
+
+	candidates []string
+	typeRepr   string
+}
+
+func (f enumFlag) TypeRepr() string     { return f.typeRepr }
+func (f enumFlag) Candidates() []string { return slices.Clone(f.candidates) }
+func (f enumFlag) Unwrap() Flag         { return f.Flag }
+
+// enumCandidates converts the allowed values to strings.
+// It panics if no allowed values are provided.
+func enumCandidates[T ~string](allowed []T) []string {
+	if len(allowed) == 0 {
+		panic("allowed values must be non-empty")
+	}
+
+	candidates := make([]string, len(allowed))
+	for i, value := range allowed {
+		candidates[i] = string(value)
+	}
+
+	return candidates
+}
+
+// checkEnumValue returns an error if the provided value is not one of the candidates.
+// The error suggests the closest candidate, if any is close enough.
+func checkEnumValue(value string, candidates []string) error {
+	if slices.Contains(candidates, value) {
+		return nil
+	}
+
+	err := fmt.Errorf("invalid value %q: must be one of %s", value, strings.Join(candidates, ", "))
+	if suggestion := closestCandidate(value, candidates); suggestion != "" {
+		err = fmt.Errorf("%w (did you mean %q?)", err, suggestion)
+	}
+
+	return err
+}
+
+// closestCandidate returns the candidate with the smallest edit distance to the provided value,
+// or an empty string if none is close enough to be a plausible typo: a third of the value length
+// can be edited, with at least one edit.
+func closestCandidate(value string, candidates []string) string {
+	var (
+		closest      string
+		bestDistance = max(len(value)/3, 1) + 1
+	)
+
+	for _, candidate := range candidates {
+		if distance := editDistance(strings.ToLower(value), strings.ToLower(candidate)); distance < bestDistance {
+			closest, bestDistance = candidate, distance
+		}
+	}
+
+	return closest
+}
+
+// editDistance returns the minimum number of single-character edits (insertions, deletions,
+// substitutions or transpositions of adjacent characters) required to change a into b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	beforePrevious := make([]int, len(rb)+1)
+
+	previous := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := range ra {
+		current := make([]int, len(rb)+1)
+		current[0] = i + 1
+
+		for j := range rb {
+			substitution := previous[j]
+			if ra[i] != rb[j] {
+				substitution++
+			}
+
+			current[j+1] = min(previous[j+1]+1, current[j]+1, substitution)
+
+			if i > 0 && j > 0 && ra[i] == rb[j-1] && ra[i-1] == rb[j] {
+				current[j+1] = min(current[j+1], beforePrevious[j-1]+1)
+			}
+		}
+
+		beforePrevious, previous = previous, current
+	}
+
+	return previous[len(rb)]
+}
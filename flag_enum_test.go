@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+)
+
+type testEnum string
+
+func Test_NewEnumFlag(t *testing.T) {
+	var dest testEnum
+
+	flag := NewEnumFlag("level", "l", &dest, []testEnum{"debug", "info", "warn"}, "descr", WithRequired())
+	test.Assert(t, flag.LongName() == "level" && flag.ShortName() == "l" && flag.Description() == "descr")
+	test.Assert(t, flag.TypeRepr() == "{debug|info|warn}")
+	test.Assert(t, flag.(FlagWrapper).Unwrap().(FlagRequired).Required())
+
+	candidates := flag.(FlagCandidates).Candidates()
+	test.Assert(t, len(candidates) == 3 && candidates[0] == "debug" && candidates[2] == "warn")
+
+	t.Run("allowed value", func(t *testing.T) {
+		test.Require(t, flag.FromString("info") == nil)
+		test.Assert(t, dest == "info" && flag.String() == "info" && flag.IsSet())
+	})
+
+	t.Run("unknown value", func(t *testing.T) {
+		err := flag.FromString("inof")
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == `invalid value "inof": must be one of debug, info, warn (did you mean "info"?)`, err.Error())
+		test.Assert(t, dest == "info")
+
+		err = flag.FromString("trace")
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == `invalid value "trace": must be one of debug, info, warn`, err.Error())
+	})
+
+	t.Run("no allowed values", func(t *testing.T) {
+		test.Assert(check.Panics(t, func() {
+			NewEnumFlag("level", "", &dest, nil, "")
+		}, func(reason any) error {
+			if strings.Contains(reason.(string), "allowed values must be non-empty") {
+				return nil
+			}
+
+			return errors.New("expected different panic reason")
+		}))
+	})
+}
+
+func Test_NewEnumSliceFlag(t *testing.T) {
+	var dest []testEnum
+
+	flag := NewEnumSliceFlag("formats", "", &dest, []testEnum{"json", "yaml"}, "")
+	test.Assert(t, flag.TypeRepr() == "[]{json|yaml}")
+	test.Assert(t, len(flag.(FlagCandidates).Candidates()) == 2)
+
+	test.Require(t, flag.FromString("json, yaml") == nil)
+	test.Assert(t, len(dest) == 2 && dest[0] == "json" && dest[1] == "yaml")
+	test.Assert(t, flag.String() == "[json,yaml]")
+
+	err := flag.FromString("json,yml")
+	test.Require(t, err != nil)
+	test.Assert(t, err.Error() == `invalid value "yml": must be one of json, yaml (did you mean "yaml"?)`, err.Error())
+}
+
+func Test_closestCandidate(t *testing.T) {
+	candidates := []string{"debug", "info", "warning", "error"}
+
+	test.Assert(t, closestCandidate("debgu", candidates) == "debug")
+	test.Assert(t, closestCandidate("INFO", candidates) == "info")
+	test.Assert(t, closestCandidate("warn", candidates) == "")
+	test.Assert(t, closestCandidate("eror", candidates) == "error")
+	test.Assert(t, closestCandidate("dbg", candidates) == "", "short values allow a single edit")
+	test.Assert(t, closestCandidate("wraning", candidates) == "warning")
+	test.Assert(t, closestCandidate("wrnig", candidates) == "")
+	test.Assert(t, closestCandidate("something", candidates) == "")
+}
+
+func Test_editDistance(t *testing.T) {
+	test.Assert(t, editDistance("", "") == 0)
+	test.Assert(t, editDistance("abc", "") == 3)
+	test.Assert(t, editDistance("", "abc") == 3)
+	test.Assert(t, editDistance("kitten", "sitting") == 3)
+	test.Assert(t, editDistance("flaw", "lawn") == 2)
+	test.Assert(t, editDistance("inof", "info") == 1)
+	test.Assert(t, editDistance("ca", "abc") == 3)
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultMaxFileValueSize is the maximum size of the values read from files, unless changed with WithMaxFileSize.
+const DefaultMaxFileValueSize ByteSize = 1 << 20
+
+// FileValueOption defines options to customize the way values are read by flags created with WithFileValue.
+type FileValueOption func(*fileValue)
+
+// WithMaxFileSize changes the maximum size of the values read from files or the standard input.
+// Bigger values are rejected instead of being truncated.
+func WithMaxFileSize(size ByteSize) FileValueOption {
+	return func(f *fileValue) { f.maxSize = size }
+}
+
+// WithFileTrim changes the way the values read from files or the standard input are trimmed.
+// By default, a single trailing newline is removed, like the one most editors add ; strings.TrimSpace
+// removes all the surrounding spaces. Note that flags created with NewFlagValuer already trim spaces.
+func WithFileTrim(trim func(string) string) FileValueOption {
+	return func(f *fileValue) { f.trim = trim }
+}
+
+// WithStdin changes the reader used to read the value when it is "-", which is os.Stdin by default.
+func WithStdin(stdin io.Reader) FileValueOption {
+	return func(f *fileValue) { f.stdin = stdin }
+}
+
+// WithFileValue makes the flag read its value from a file when the provided value is prefixed by an @,
+// like --password @/run/secrets/password, or from the standard input when the provided value is "-".
+// It avoids passing secrets or large values on the command line. A value prefixed by @@ is taken literally,
+// without its first @. Values are limited to DefaultMaxFileValueSize, and a single trailing newline is removed ;
+// this can be changed by options.
+func WithFileValue(opts ...FileValueOption) FlagOption {
+	return func(f *flagValue) {
+		file := &fileValue{maxSize: DefaultMaxFileValueSize, trim: trimTrailingNewline, stdin: os.Stdin}
+		for _, opt := range opts {
+			opt(file)
+		}
+
+		f.file = file
+	}
+}
+
+// ReadFileValue reads the value stored in the file at path, the way flags created with WithFileValue do
+// by default: files bigger than maxSize are rejected, and a single trailing newline is removed.
+func ReadFileValue(path string, maxSize ByteSize) (string, error) {
+	value, err := readFileValue(path, maxSize)
+	if err != nil {
+		return "", err
+	}
+
+	return trimTrailingNewline(value), nil
+}
+
+// fileValue holds the way a flag reads its value from files or the standard input.
+type fileValue struct {
+	maxSize ByteSize
+	trim    func(string) string
+	stdin   io.Reader
+}
+
+// resolve returns the value designated by raw: the content of the file or the standard input
+// it designates, or raw itself.
+func (f *fileValue) resolve(raw string) (string, error) {
+	var (
+		value string
+		err   error
+	)
+
+	switch {
+	case strings.HasPrefix(raw, "@@"):
+		return raw[1:], nil
+	case strings.HasPrefix(raw, "@"):
+		value, err = readFileValue(raw[1:], f.maxSize)
+	case raw == "-":
+		value, err = readValue(f.stdin, "stdin", f.maxSize)
+	default:
+		return raw, nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return f.trim(value), nil
+}
+
+// readFileValue reads the whole content of the file at path, which must not be bigger than maxSize.
+func readFileValue(path string, maxSize ByteSize) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read value from file: %w", err)
+	}
+	defer file.Close() //nolint:errcheck // file is only read
+
+	return readValue(file, fmt.Sprintf("file %q", path), maxSize)
+}
+
+// readValue reads the whole content of r, named name in errors, which must not be bigger than maxSize.
+func readValue(r io.Reader, name string, maxSize ByteSize) (string, error) {
+	content, err := io.ReadAll(io.LimitReader(r, int64(maxSize)+1)) //nolint:gosec // sizes that big are not realistic
+	if err != nil {
+		return "", fmt.Errorf("unable to read value from %s: %w", name, err)
+	}
+
+	if ByteSize(len(content)) > maxSize {
+		return "", fmt.Errorf("value of %s exceeds the maximum size of %s", name, maxSize)
+	}
+
+	return string(content), nil
+}
+
+// trimTrailingNewline removes a single trailing newline, if any.
+func trimTrailingNewline(value string) string {
+	if trimmed, found := strings.CutSuffix(value, "\n"); found {
+		return strings.TrimSuffix(trimmed, "\r")
+	}
+
+	return value
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+)
+
+func Test_WithFileValue(t *testing.T) {
+	dir := t.TempDir()
+	test.Require(t, os.WriteFile(filepath.Join(dir, "secret"), []byte("  s3cr3t  \n"), 0o600) == nil)
+	test.Require(t, os.WriteFile(filepath.Join(dir, "tags"), []byte("a,b\r\n"), 0o600) == nil)
+
+	for name, tc := range map[string]struct {
+		raw         string
+		opts        []FileValueOption
+		expected    string
+		expectedErr string
+	}{
+		"raw value": {
+			raw:      "value",
+			expected: "value",
+		},
+		"escaped value": {
+			raw:      "@@value",
+			expected: "@value",
+		},
+		"file": {
+			raw:      "@" + filepath.Join(dir, "secret"),
+			expected: "  s3cr3t  ",
+		},
+		"file with custom trimming": {
+			raw:      "@" + filepath.Join(dir, "secret"),
+			opts:     []FileValueOption{WithFileTrim(strings.TrimSpace)},
+			expected: "s3cr3t",
+		},
+		"stdin": {
+			raw:      "-",
+			opts:     []FileValueOption{WithStdin(strings.NewReader("from stdin\n"))},
+			expected: "from stdin",
+		},
+		"missing file": {
+			raw:         "@" + filepath.Join(dir, "missing"),
+			expectedErr: "unable to read value from file: open ",
+		},
+		"file too big": {
+			raw:         "@" + filepath.Join(dir, "secret"),
+			opts:        []FileValueOption{WithMaxFileSize(4)},
+			expectedErr: "exceeds the maximum size of 4B",
+		},
+		"failing stdin": {
+			raw:         "-",
+			opts:        []FileValueOption{WithStdin(iotest.ErrReader(errors.New("boom")))},
+			expectedErr: "unable to read value from stdin: boom",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// valuers created with NewFlagValuer trim spaces, use an untrimmed one to check the trimming
+			valuer := new(untrimmedValuer)
+			flag := NewFlag("a", "", valuer, "", WithFileValue(tc.opts...))
+
+			err := flag.FromString(tc.raw)
+			if tc.expectedErr != "" {
+				test.Assert(t, err != nil && strings.Contains(err.Error(), tc.expectedErr), "%v", err)
+				test.Assert(t, !flag.IsSet())
+
+				return
+			}
+
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, flag.IsSet())
+			test.Assert(t, valuer.value == tc.expected, valuer.value)
+		})
+	}
+
+	t.Run("slices", func(t *testing.T) {
+		var tags []string
+
+		flag := NewBuiltinSliceFlag("tags", "", &tags, "", WithFileValue())
+		test.Require(t, flag.FromString("@"+filepath.Join(dir, "tags")) == nil)
+		test.Assert(check.Compare(t, tags, []string{"a", "b"}))
+	})
+
+	t.Run("not opted in", func(t *testing.T) {
+		var dest string
+
+		test.Require(t, NewBuiltinFlag("a", "", &dest, "").FromString("@"+filepath.Join(dir, "secret")) == nil)
+		test.Assert(t, dest == "@"+filepath.Join(dir, "secret"))
+	})
+}
+
+func Test_ReadFileValue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "value")
+	test.Require(t, os.WriteFile(path, []byte("value\n\n"), 0o600) == nil)
+
+	value, err := ReadFileValue(path, DefaultMaxFileValueSize)
+	test.Require(t, err == nil, "%v", err)
+	test.Assert(t, value == "value\n")
+
+	_, err = ReadFileValue(path, 2)
+	test.Assert(t, err != nil && strings.Contains(err.Error(), "exceeds the maximum size of 2B"), "%v", err)
+}
+
+type untrimmedValuer struct {
+	value string
+	set   bool
+}
+
+func (v *untrimmedValuer) Destination() any { return &v.value }
+func (v *untrimmedValuer) IsSet() bool      { return v.set }
+func (v *untrimmedValuer) String() string   { return v.value }
+func (*untrimmedValuer) TypeRepr() string   { return "string" }
+
+func (v *untrimmedValuer) FromString(raw string) error {
+	v.value, v.set = raw, true
+	return nil
+}
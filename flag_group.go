@@ -0,0 +1,79 @@
+package cli
+
+// FlagGroupConstraint defines how the flags of a group relate to each other.
+type FlagGroupConstraint uint8
+
+const (
+	// FlagGroupMutuallyExclusive allows at most one flag of the group to be set.
+	FlagGroupMutuallyExclusive FlagGroupConstraint = iota + 1
+	// FlagGroupRequiredTogether requires either all the flags of the group to be set, or none of them.
+	FlagGroupRequiredTogether
+	// FlagGroupOneRequired requires at least one flag of the group to be set.
+	FlagGroupOneRequired
+)
+
+// FlagGroup is a set of flags bound by a constraint.
+// Mappers check the constraint once the command hooks ran, like required flags.
+type FlagGroup struct {
+	Constraint FlagGroupConstraint
+	Flags      []Flag
+}
+
+// FlagGrouped can be implemented by flags that belong to groups.
+type FlagGrouped interface{ FlagGroups() []*FlagGroup }
+
+// MutuallyExclusiveFlags groups the provided flags so at most one of them can be set.
+// The returned flags must be used in place of the provided ones.
+//
+// Example:
+//
+//	func (cmd *Command) Flags() []cli.Flag {
+//		return cli.MutuallyExclusiveFlags(
+//			cli.NewBuiltinFlag("file", "f", &cmd.file, "read from file"),
+//			cli.NewBuiltinFlag("stdin", "", &cmd.stdin, "read from stdin"),
+//		)
+//	}
+//
+// Groups can be combined: wrapping mutually exclusive flags with OneRequiredFlags
+// requires exactly one of them to be set.
+func MutuallyExclusiveFlags(flags ...Flag) []Flag {
+	return newFlagGroup(FlagGroupMutuallyExclusive, flags)
+}
+
+// RequiredTogetherFlags groups the provided flags so either all of them, or none of them, are set.
+// The returned flags must be used in place of the provided ones.
+func RequiredTogetherFlags(flags ...Flag) []Flag {
+	return newFlagGroup(FlagGroupRequiredTogether, flags)
+}
+
+// OneRequiredFlags groups the provided flags so at least one of them is set.
+// The returned flags must be used in place of the provided ones.
+func OneRequiredFlags(flags ...Flag) []Flag {
+	return newFlagGroup(FlagGroupOneRequired, flags)
+}
+
+// newFlagGroup wraps the provided flags to make them belong to a new group.
+// It panics if less than two flags are provided.
+func newFlagGroup(constraint FlagGroupConstraint, flags []Flag) []Flag {
+	if len(flags) < 2 {
+		panic("a group requires at least two flags")
+	}
+
+	group := &FlagGroup{Constraint: constraint, Flags: flags}
+
+	grouped := make([]Flag, len(flags))
+	for i, flag := range flags {
+		grouped[i] = &groupedFlag{Flag: flag, group: group}
+	}
+
+	return grouped
+}
+
+type groupedFlag struct {
+	Flag
+
+	group *FlagGroup
+}
+
+func (f groupedFlag) FlagGroups() []*FlagGroup { return []*FlagGroup{f.group} }
+func (f groupedFlag) Unwrap() Flag             { return f.Flag }
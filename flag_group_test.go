@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+)
+
+func Test_FlagGroups(t *testing.T) {
+	var a, b string
+
+	for name, tt := range map[string]struct {
+		constructor func(...Flag) []Flag
+		constraint  FlagGroupConstraint
+	}{
+		"mutually exclusive": {constructor: MutuallyExclusiveFlags, constraint: FlagGroupMutuallyExclusive},
+		"required together":  {constructor: RequiredTogetherFlags, constraint: FlagGroupRequiredTogether},
+		"one required":       {constructor: OneRequiredFlags, constraint: FlagGroupOneRequired},
+	} {
+		t.Run(name, func(t *testing.T) {
+			flagA, flagB := NewBuiltinFlag("a", "", &a, "descr"), NewBuiltinFlag("b", "", &b, "")
+
+			grouped := tt.constructor(flagA, flagB)
+			test.Require(t, len(grouped) == 2)
+
+			for i, original := range []Flag{flagA, flagB} {
+				test.Assert(t, grouped[i].LongName() == original.LongName())
+				test.Assert(t, grouped[i].Description() == original.Description())
+				test.Assert(t, grouped[i].(FlagWrapper).Unwrap() == original)
+
+				groups := grouped[i].(FlagGrouped).FlagGroups()
+				test.Require(t, len(groups) == 1)
+				test.Assert(t, groups[0].Constraint == tt.constraint)
+				test.Assert(t, len(groups[0].Flags) == 2 && groups[0].Flags[0] == flagA && groups[0].Flags[1] == flagB)
+			}
+
+			test.Assert(t, grouped[0].(FlagGrouped).FlagGroups()[0] == grouped[1].(FlagGrouped).FlagGroups()[0])
+
+			test.Require(t, grouped[0].FromString("value") == nil)
+			test.Assert(t, a == "value" && flagA.IsSet())
+		})
+	}
+
+	t.Run("less than two flags", func(t *testing.T) {
+		test.Assert(check.Panics(t, func() {
+			MutuallyExclusiveFlags(NewBuiltinFlag("a", "", &a, ""))
+		}, func(reason any) error {
+			if strings.Contains(reason.(string), "a group requires at least two flags") {
+				return nil
+			}
+
+			return errors.New("expected different panic reason")
+		}))
+	})
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// WithAppend makes each use of a slice flag append its values to the previous ones,
+// like -t a -t b,c, instead of replacing them. Values set by default are kept,
+// unless WithResetDefault is also used. It has no effect on other flags.
+func WithAppend() FlagOption {
+	return func(f *flagValue) { f.configureSlice(func(p *sliceParsing) { p.append = true }) }
+}
+
+// WithResetDefault makes the first use of a slice flag created with WithAppend
+// discard the values set by default, the next uses appending their values.
+// It has no effect on other flags.
+func WithResetDefault() FlagOption {
+	return func(f *flagValue) { f.configureSlice(func(p *sliceParsing) { p.resetDefault = true }) }
+}
+
+// WithSeparator changes the separator of the values of a slice flag, which is a comma by default.
+// An empty separator disables the splitting, each use of the flag providing a single value,
+// which is mostly useful along with WithAppend. It has no effect on other flags.
+func WithSeparator(separator string) FlagOption {
+	return func(f *flagValue) { f.configureSlice(func(p *sliceParsing) { p.separator = separator }) }
+}
+
+// WithCSV makes the values of a slice flag parsed like a CSV record, allowing
+// values to contain the separator when quoted, like "a,b",c. It requires the
+// separator to be a single character. It has no effect on other flags.
+func WithCSV() FlagOption {
+	return func(f *flagValue) { f.configureSlice(func(p *sliceParsing) { p.csv = true }) }
+}
+
+// configureSlice applies configure to the parsing rules of the flag, if it handles a slice.
+func (f *flagValue) configureSlice(configure func(*sliceParsing)) {
+	if valuer, ok := f.FlagValuer.(*sliceFlagValuer); ok {
+		configure(valuer.parsing)
+	}
+}
+
+// SplitValues splits a raw value into the values of a slice, the way slice flags do.
+// Values are separated by separator and trimmed of surrounding spaces ; an empty separator
+// does not split the raw value. When csv is true, the raw value is parsed like a CSV record,
+// quoted values being able to contain the separator.
+func SplitValues(raw, separator string, csv bool) ([]string, error) {
+	var values []string
+
+	switch {
+	case csv:
+		record, err := splitCSVValues(raw, separator)
+		if err != nil {
+			return nil, err
+		}
+
+		values = record
+	case separator == "":
+		values = []string{raw}
+	default:
+		values = strings.Split(raw, separator)
+	}
+
+	for i, value := range values {
+		values[i] = strings.TrimSpace(value)
+	}
+
+	return values, nil
+}
+
+// splitCSVValues parses raw as a single CSV record using separator as the fields delimiter.
+func splitCSVValues(raw, separator string) ([]string, error) {
+	delimiter, size := utf8.DecodeRuneInString(separator)
+	if size == 0 || size != len(separator) {
+		return nil, fmt.Errorf("CSV parsing requires a single character separator, got %q", separator)
+	}
+
+	reader := csv.NewReader(strings.NewReader(raw))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	record, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return []string{""}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV values %q: %w", raw, err)
+	}
+
+	if _, err := reader.Read(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid CSV values %q: expected a single line", raw)
+	}
+
+	return record, nil
+}
+
+// sliceParsing holds the rules to parse the values of a slice flag.
+type sliceParsing struct {
+	separator    string
+	csv          bool
+	append       bool
+	resetDefault bool
+}
+
+// sliceFlagValuer is a FlagValuer for slices whose parsing rules can be changed by options.
+type sliceFlagValuer struct {
+	FlagValuer
+
+	parsing *sliceParsing
+}
+
+// newSliceFlagValuer creates a FlagValuer for slices, using parse and toString to handle each value.
+// The raw value is expected to be a comma-separated list of values, which can be changed by options.
+func newSliceFlagValuer[T any](destination *[]T, parse func(string) (T, error), toString func(T) string) FlagValuer {
+	var (
+		parsing = &sliceParsing{separator: ","}
+		valuer  FlagValuer
+	)
+
+	valuer = NewFlagValuer(destination,
+		func(raw string) ([]T, error) {
+			rawValues, err := SplitValues(raw, parsing.separator, parsing.csv)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make([]T, len(rawValues))
+
+			for i, rawValue := range rawValues {
+				value, err := parse(rawValue)
+				if err != nil {
+					return nil, err
+				}
+
+				values[i] = value
+			}
+
+			if parsing.append && (valuer.IsSet() || !parsing.resetDefault) {
+				values = append(slices.Clone(*destination), values...)
+			}
+
+			return values, nil
+		},
+		func(values []T) string { return sliceToString(values, toString) },
+	)
+
+	return &sliceFlagValuer{FlagValuer: valuer, parsing: parsing}
+}
+
+// DefaultString implements FlagDefault.
+func (v *sliceFlagValuer) DefaultString() string { return valuerDefaultString(v.FlagValuer) }
+
+// ResetToDefault implements FlagDefault.
+func (v *sliceFlagValuer) ResetToDefault() { resetValuerToDefault(v.FlagValuer) }
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+)
+
+func Test_SplitValues(t *testing.T) {
+	for name, tt := range map[string]struct {
+		raw           string
+		separator     string
+		csv           bool
+		expected      []string
+		expectedError string
+	}{
+		"default separator":     {raw: " a, b ,c", separator: ",", expected: []string{"a", "b", "c"}},
+		"custom separator":      {raw: "a,b;c", separator: ";", expected: []string{"a,b", "c"}},
+		"multi-char separator":  {raw: "a::b", separator: "::", expected: []string{"a", "b"}},
+		"no separator":          {raw: "a,b;c", expected: []string{"a,b;c"}},
+		"csv":                   {raw: `"a,b", c,"d ""e"""`, separator: ",", csv: true, expected: []string{"a,b", "c", `d "e"`}},
+		"csv custom separator":  {raw: `a;"b;c"`, separator: ";", csv: true, expected: []string{"a", "b;c"}},
+		"csv empty":             {raw: "", separator: ",", csv: true, expected: []string{""}},
+		"csv invalid quotes":    {raw: `a,"b`, separator: ",", csv: true, expectedError: "invalid CSV values"},
+		"csv multiple lines":    {raw: "a\nb", separator: ",", csv: true, expectedError: "expected a single line"},
+		"csv invalid separator": {raw: "a", separator: "::", csv: true, expectedError: "requires a single character separator"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			values, err := SplitValues(tt.raw, tt.separator, tt.csv)
+			if tt.expectedError != "" {
+				test.Assert(t, err != nil && strings.Contains(err.Error(), tt.expectedError), err)
+				return
+			}
+
+			test.Require(t, err == nil, err)
+			test.Assert(t, slices.Equal(values, tt.expected), values)
+		})
+	}
+}
+
+func Test_newSliceFlagValuer_options(t *testing.T) {
+	t.Run("replace by default", func(t *testing.T) {
+		value := []string{"default"}
+
+		flag := NewBuiltinSliceFlag("tags", "", &value, "")
+		test.Require(t, flag.FromString("a,b") == nil)
+		test.Require(t, flag.FromString("c") == nil)
+		test.Assert(t, slices.Equal(value, []string{"c"}), value)
+	})
+
+	t.Run("append", func(t *testing.T) {
+		value := []string{"default"}
+
+		flag := NewBuiltinSliceFlag("tags", "", &value, "", WithAppend())
+		test.Require(t, flag.FromString("a,b") == nil)
+		test.Require(t, flag.FromString("c") == nil)
+		test.Assert(t, slices.Equal(value, []string{"default", "a", "b", "c"}), value)
+	})
+
+	t.Run("append and reset default", func(t *testing.T) {
+		value := []string{"default"}
+
+		flag := NewBuiltinSliceFlag("tags", "", &value, "", WithAppend(), WithResetDefault())
+		test.Require(t, flag.FromString("a,b") == nil)
+		test.Require(t, flag.FromString("c") == nil)
+		test.Assert(t, slices.Equal(value, []string{"a", "b", "c"}), value)
+	})
+
+	t.Run("reset default is not consumed by invalid values", func(t *testing.T) {
+		value := []int{42}
+
+		flag := NewBuiltinSliceFlag("ints", "", &value, "", WithAppend(), WithResetDefault())
+		test.Assert(t, flag.FromString("nope") != nil)
+		test.Require(t, flag.FromString("1") == nil)
+		test.Require(t, flag.FromString("2") == nil)
+		test.Assert(t, slices.Equal(value, []int{1, 2}), value)
+	})
+
+	t.Run("separator", func(t *testing.T) {
+		var value []string
+
+		flag := NewBuiltinSliceFlag("tags", "", &value, "", WithSeparator(";"))
+		test.Require(t, flag.FromString("a,b;c") == nil)
+		test.Assert(t, slices.Equal(value, []string{"a,b", "c"}), value)
+	})
+
+	t.Run("no separator", func(t *testing.T) {
+		var value []string
+
+		flag := NewBuiltinSliceFlag("tags", "", &value, "", WithSeparator(""), WithAppend())
+		test.Require(t, flag.FromString("a,b") == nil)
+		test.Require(t, flag.FromString("c;d") == nil)
+		test.Assert(t, slices.Equal(value, []string{"a,b", "c;d"}), value)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var value []string
+
+		flag := NewBuiltinSliceFlag("tags", "", &value, "", WithCSV())
+		test.Require(t, flag.FromString(`"a,b",c`) == nil)
+		test.Assert(t, slices.Equal(value, []string{"a,b", "c"}), value)
+		test.Assert(t, flag.FromString(`"a`) != nil)
+	})
+
+	t.Run("options on other flags", func(t *testing.T) {
+		var value string
+
+		flag := NewBuiltinFlag("str", "", &value, "", WithAppend(), WithSeparator(";"))
+		test.Require(t, flag.FromString("a;b") == nil)
+		test.Require(t, flag.FromString("c") == nil)
+		test.Assert(t, value == "c")
+	})
+
+	t.Run("other slice flags", func(t *testing.T) {
+		var value []testEnum
+
+		flag := NewEnumSliceFlag("formats", "", &value, []testEnum{"json", "yaml"}, "", WithAppend())
+		test.Require(t, flag.FromString("json") == nil)
+		test.Require(t, flag.FromString("yaml") == nil)
+		test.Assert(t, slices.Equal(value, []testEnum{"json", "yaml"}), value)
+	})
+}
@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// NewDurationFlag creates a Flag for durations, parsed by time.ParseDuration, like "1h30m".
+// See NewFlag for more details.
+func NewDurationFlag(longName, shortName string, destination *time.Duration, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, NewFlagValuer(destination, time.ParseDuration, time.Duration.String), description, opts...)
+}
+
+// NewDurationPointerFlag creates a Flag for pointers to durations.
+// See NewDurationFlag for more details.
+func NewDurationPointerFlag(longName, shortName string, destination **time.Duration, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newPointerFlagValuer(destination, time.ParseDuration, time.Duration.String), description, opts...)
+}
+
+// NewDurationSliceFlag creates a Flag for slices of durations.
+// The flag value is expected to be a comma-separated list of values.
+// See NewDurationFlag for more details.
+func NewDurationSliceFlag(longName, shortName string, destination *[]time.Duration, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, time.ParseDuration, time.Duration.String), description, opts...)
+}
+
+// NewTimeFlag creates a Flag for times, parsed and formatted with the provided layout, like time.RFC3339.
+// See NewFlag for more details.
+func NewTimeFlag(longName, shortName string, destination *time.Time, layout, description string, opts ...FlagOption) Flag {
+	parse, toString := timeParser(layout)
+	return NewFlag(longName, shortName, NewFlagValuer(destination, parse, toString), description, opts...)
+}
+
+// NewTimePointerFlag creates a Flag for pointers to times.
+// See NewTimeFlag for more details.
+func NewTimePointerFlag(longName, shortName string, destination **time.Time, layout, description string, opts ...FlagOption) Flag {
+	parse, toString := timeParser(layout)
+	return NewFlag(longName, shortName, newPointerFlagValuer(destination, parse, toString), description, opts...)
+}
+
+// NewTimeSliceFlag creates a Flag for slices of times.
+// The flag value is expected to be a comma-separated list of values.
+// See NewTimeFlag for more details.
+func NewTimeSliceFlag(longName, shortName string, destination *[]time.Time, layout, description string, opts ...FlagOption) Flag {
+	parse, toString := timeParser(layout)
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, parse, toString), description, opts...)
+}
+
+// timeParser returns the functions to parse and format times with the provided layout.
+func timeParser(layout string) (func(string) (time.Time, error), func(time.Time) string) {
+	return func(raw string) (time.Time, error) { return time.Parse(layout, raw) },
+		func(t time.Time) string { return t.Format(layout) }
+}
+
+// NewURLFlag creates a Flag for URLs, parsed by url.Parse.
+// As the destination is already a pointer, a nil URL means the flag is not set.
+// See NewFlag for more details.
+func NewURLFlag(longName, shortName string, destination **url.URL, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, NewFlagValuer(destination, url.Parse, urlToString), description, opts...)
+}
+
+// NewURLSliceFlag creates a Flag for slices of URLs.
+// The flag value is expected to be a comma-separated list of values.
+// See NewURLFlag for more details.
+func NewURLSliceFlag(longName, shortName string, destination *[]*url.URL, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, url.Parse, urlToString), description, opts...)
+}
+
+// urlToString converts an URL to its string representation.
+func urlToString(u *url.URL) string {
+	if u == nil {
+		return "<nil>"
+	}
+
+	return u.String()
+}
+
+// NewIPFlag creates a Flag for IP addresses, parsed by net.ParseIP.
+// As the destination is a slice, a nil IP means the flag is not set.
+// See NewFlag for more details.
+func NewIPFlag(longName, shortName string, destination *net.IP, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, NewFlagValuer(destination, parseIP, net.IP.String), description, opts...)
+}
+
+// NewIPSliceFlag creates a Flag for slices of IP addresses.
+// The flag value is expected to be a comma-separated list of values.
+// See NewIPFlag for more details.
+func NewIPSliceFlag(longName, shortName string, destination *[]net.IP, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, parseIP, net.IP.String), description, opts...)
+}
+
+// parseIP parses an IP address, returning an error when the address is invalid.
+func parseIP(raw string) (net.IP, error) {
+	ip := net.ParseIP(raw)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", raw)
+	}
+
+	return ip, nil
+}
+
+// NewAddrFlag creates a Flag for IP addresses, parsed by netip.ParseAddr.
+// See NewFlag for more details.
+func NewAddrFlag(longName, shortName string, destination *netip.Addr, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, NewFlagValuer(destination, netip.ParseAddr, netip.Addr.String), description, opts...)
+}
+
+// NewAddrPointerFlag creates a Flag for pointers to IP addresses.
+// See NewAddrFlag for more details.
+func NewAddrPointerFlag(longName, shortName string, destination **netip.Addr, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newPointerFlagValuer(destination, netip.ParseAddr, netip.Addr.String), description, opts...)
+}
+
+// NewAddrSliceFlag creates a Flag for slices of IP addresses.
+// The flag value is expected to be a comma-separated list of values.
+// See NewAddrFlag for more details.
+func NewAddrSliceFlag(longName, shortName string, destination *[]netip.Addr, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, netip.ParseAddr, netip.Addr.String), description, opts...)
+}
+
+// NewPrefixFlag creates a Flag for IP networks in CIDR notation, parsed by netip.ParsePrefix, like "10.0.0.0/8".
+// See NewFlag for more details.
+func NewPrefixFlag(longName, shortName string, destination *netip.Prefix, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, NewFlagValuer(destination, netip.ParsePrefix, netip.Prefix.String), description, opts...)
+}
+
+// NewPrefixPointerFlag creates a Flag for pointers to IP networks.
+// See NewPrefixFlag for more details.
+func NewPrefixPointerFlag(longName, shortName string, destination **netip.Prefix, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newPointerFlagValuer(destination, netip.ParsePrefix, netip.Prefix.String), description, opts...)
+}
+
+// NewPrefixSliceFlag creates a Flag for slices of IP networks.
+// The flag value is expected to be a comma-separated list of values.
+// See NewPrefixFlag for more details.
+func NewPrefixSliceFlag(longName, shortName string, destination *[]netip.Prefix, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, netip.ParsePrefix, netip.Prefix.String), description, opts...)
+}
+
+// NewRegexpFlag creates a Flag for regular expressions, compiled by regexp.Compile.
+// As the destination is already a pointer, a nil regular expression means the flag is not set.
+// See NewFlag for more details.
+func NewRegexpFlag(longName, shortName string, destination **regexp.Regexp, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, NewFlagValuer(destination, regexp.Compile, regexpToString), description, opts...)
+}
+
+// NewRegexpSliceFlag creates a Flag for slices of regular expressions.
+// The flag value is expected to be a comma-separated list of values.
+// See NewRegexpFlag for more details.
+func NewRegexpSliceFlag(longName, shortName string, destination *[]*regexp.Regexp, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, regexp.Compile, regexpToString), description, opts...)
+}
+
+// regexpToString converts a regular expression to its string representation.
+func regexpToString(r *regexp.Regexp) string {
+	if r == nil {
+		return "<nil>"
+	}
+
+	return r.String()
+}
+
+// NewByteSizeFlag creates a Flag for sizes in bytes, parsed by ParseByteSize, like "10MiB".
+// See NewFlag for more details.
+func NewByteSizeFlag(longName, shortName string, destination *ByteSize, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, NewFlagValuer(destination, ParseByteSize, ByteSize.String), description, opts...)
+}
+
+// NewByteSizePointerFlag creates a Flag for pointers to sizes in bytes.
+// See NewByteSizeFlag for more details.
+func NewByteSizePointerFlag(longName, shortName string, destination **ByteSize, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newPointerFlagValuer(destination, ParseByteSize, ByteSize.String), description, opts...)
+}
+
+// NewByteSizeSliceFlag creates a Flag for slices of sizes in bytes.
+// The flag value is expected to be a comma-separated list of values.
+// See NewByteSizeFlag for more details.
+func NewByteSizeSliceFlag(longName, shortName string, destination *[]ByteSize, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, ParseByteSize, ByteSize.String), description, opts...)
+}
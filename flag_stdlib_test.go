@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krostar/test"
+)
+
+func Test_NewDurationFlags(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		var value time.Duration
+
+		flag := NewDurationFlag("longName", "s", &value, "description")
+		test.Assert(t, flag.LongName() == "longName" && flag.ShortName() == "s" && flag.Description() == "description")
+		test.Assert(t, flag.TypeRepr() == "time.Duration")
+
+		err := flag.FromString("abc")
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "invalid duration"))
+
+		test.Assert(t, flag.FromString(" 1h30m ") == nil)
+		test.Assert(t, value == 90*time.Minute)
+		test.Assert(t, flag.String() == "1h30m0s")
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		var value *time.Duration
+
+		flag := NewDurationPointerFlag("longName", "", &value, "")
+		test.Assert(t, flag.String() == "<nil>")
+		test.Assert(t, flag.FromString("2s") == nil)
+		test.Assert(t, value != nil && *value == 2*time.Second)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		var value []time.Duration
+
+		flag := NewDurationSliceFlag("longName", "", &value, "")
+		test.Assert(t, flag.FromString("1s, 1m") == nil)
+		test.Assert(t, flag.String() == "[1s,1m0s]")
+	})
+}
+
+func Test_NewTimeFlags(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		var value time.Time
+
+		flag := NewTimeFlag("longName", "", &value, time.DateOnly, "")
+		test.Assert(t, flag.TypeRepr() == "time.Time")
+
+		err := flag.FromString("2024-01-02T00:00:00Z")
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "extra text"))
+
+		test.Assert(t, flag.FromString("2024-01-02") == nil)
+		test.Assert(t, value.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+		test.Assert(t, flag.String() == "2024-01-02")
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		var value *time.Time
+
+		flag := NewTimePointerFlag("longName", "", &value, time.RFC3339, "")
+		test.Assert(t, flag.FromString("2024-01-02T03:04:05Z") == nil)
+		test.Assert(t, value != nil && value.Hour() == 3)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		var value []time.Time
+
+		flag := NewTimeSliceFlag("longName", "", &value, time.DateOnly, "")
+		test.Assert(t, flag.FromString("2024-01-02,2024-02-03") == nil)
+		test.Assert(t, flag.String() == "[2024-01-02,2024-02-03]")
+	})
+}
+
+func Test_NewURLFlags(t *testing.T) {
+	var (
+		value  *url.URL
+		values []*url.URL
+	)
+
+	flag := NewURLFlag("longName", "", &value, "")
+	test.Assert(t, flag.TypeRepr() == "*url.URL")
+	test.Assert(t, flag.String() == "<nil>")
+
+	err := flag.FromString("://missing-scheme")
+	test.Assert(t, err != nil && strings.Contains(err.Error(), "missing protocol scheme"))
+
+	test.Assert(t, flag.FromString("https://example.com/path") == nil)
+	test.Assert(t, value != nil && value.Host == "example.com")
+	test.Assert(t, flag.String() == "https://example.com/path")
+
+	flag = NewURLSliceFlag("longName", "", &values, "")
+	test.Assert(t, flag.FromString("https://a.com,https://b.com") == nil)
+	test.Assert(t, flag.String() == "[https://a.com,https://b.com]")
+}
+
+func Test_NewIPFlags(t *testing.T) {
+	var (
+		value  net.IP
+		values []net.IP
+	)
+
+	flag := NewIPFlag("longName", "", &value, "")
+	test.Assert(t, flag.TypeRepr() == "net.IP")
+
+	err := flag.FromString("300.0.0.1")
+	test.Assert(t, err != nil && err.Error() == `invalid IP address "300.0.0.1"`)
+
+	test.Assert(t, flag.FromString("127.0.0.1") == nil)
+	test.Assert(t, value.Equal(net.IPv4(127, 0, 0, 1)))
+	test.Assert(t, flag.String() == "127.0.0.1")
+
+	flag = NewIPSliceFlag("longName", "", &values, "")
+	test.Assert(t, flag.FromString("127.0.0.1, ::1") == nil)
+	test.Assert(t, flag.String() == "[127.0.0.1,::1]")
+}
+
+func Test_NewAddrFlags(t *testing.T) {
+	var (
+		value  netip.Addr
+		ptr    *netip.Addr
+		values []netip.Addr
+	)
+
+	flag := NewAddrFlag("longName", "", &value, "")
+	test.Assert(t, flag.TypeRepr() == "netip.Addr")
+	test.Assert(t, flag.FromString("not an ip") != nil)
+	test.Assert(t, flag.FromString("::1") == nil)
+	test.Assert(t, value == netip.IPv6Loopback())
+
+	flag = NewAddrPointerFlag("longName", "", &ptr, "")
+	test.Assert(t, flag.FromString("10.0.0.1") == nil)
+	test.Assert(t, ptr != nil && *ptr == netip.MustParseAddr("10.0.0.1"))
+
+	flag = NewAddrSliceFlag("longName", "", &values, "")
+	test.Assert(t, flag.FromString("10.0.0.1,::1") == nil)
+	test.Assert(t, flag.String() == "[10.0.0.1,::1]")
+}
+
+func Test_NewPrefixFlags(t *testing.T) {
+	var (
+		value  netip.Prefix
+		ptr    *netip.Prefix
+		values []netip.Prefix
+	)
+
+	flag := NewPrefixFlag("longName", "", &value, "")
+	test.Assert(t, flag.TypeRepr() == "netip.Prefix")
+	test.Assert(t, flag.FromString("10.0.0.0") != nil)
+	test.Assert(t, flag.FromString("10.0.0.0/8") == nil)
+	test.Assert(t, value == netip.MustParsePrefix("10.0.0.0/8"))
+
+	flag = NewPrefixPointerFlag("longName", "", &ptr, "")
+	test.Assert(t, flag.FromString("fd00::/8") == nil)
+	test.Assert(t, ptr != nil && ptr.Bits() == 8)
+
+	flag = NewPrefixSliceFlag("longName", "", &values, "")
+	test.Assert(t, flag.FromString("10.0.0.0/8,192.168.0.0/16") == nil)
+	test.Assert(t, flag.String() == "[10.0.0.0/8,192.168.0.0/16]")
+}
+
+func Test_NewRegexpFlags(t *testing.T) {
+	var (
+		value  *regexp.Regexp
+		values []*regexp.Regexp
+	)
+
+	flag := NewRegexpFlag("longName", "", &value, "")
+	test.Assert(t, flag.TypeRepr() == "*regexp.Regexp")
+	test.Assert(t, flag.String() == "<nil>")
+
+	err := flag.FromString("a(")
+	test.Assert(t, err != nil && strings.Contains(err.Error(), "missing closing )"))
+
+	test.Assert(t, flag.FromString("^a+$") == nil)
+	test.Assert(t, value != nil && value.MatchString("aa"))
+	test.Assert(t, flag.String() == "^a+$")
+
+	flag = NewRegexpSliceFlag("longName", "", &values, "")
+	test.Assert(t, flag.FromString("^a$,^b$") == nil)
+	test.Assert(t, len(values) == 2 && values[1].MatchString("b"))
+}
+
+func Test_NewByteSizeFlags(t *testing.T) {
+	var (
+		value  ByteSize
+		ptr    *ByteSize
+		values []ByteSize
+	)
+
+	flag := NewByteSizeFlag("longName", "", &value, "")
+	test.Assert(t, flag.TypeRepr() == "cli.ByteSize")
+	test.Assert(t, flag.FromString("10 parsecs") != nil)
+	test.Assert(t, flag.FromString("10MiB") == nil)
+	test.Assert(t, value == 10<<20)
+	test.Assert(t, flag.String() == "10MiB")
+
+	flag = NewByteSizePointerFlag("longName", "", &ptr, "")
+	test.Assert(t, flag.FromString("1.5kB") == nil)
+	test.Assert(t, ptr != nil && *ptr == 1500)
+
+	flag = NewByteSizeSliceFlag("longName", "", &values, "")
+	test.Assert(t, flag.FromString("1KiB,2GB") == nil)
+	test.Assert(t, flag.String() == "[1KiB,2GB]")
+}
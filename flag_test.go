@@ -2,6 +2,7 @@ package cli
 
 import (
 	"errors"
+	"slices"
 	"strings"
 	"testing"
 
@@ -21,6 +22,27 @@ func Test_NewFlag(t *testing.T) {
 		test.Assert(t, NewFlag("long", "s", nonNilValuer, "") != nil)
 	})
 
+	t.Run("options", func(t *testing.T) {
+		test.Assert(t, !NewFlag("long", "", nonNilValuer, "").(FlagRequired).Required())
+		test.Assert(t, NewFlag("long", "", nonNilValuer, "", WithRequired()).(FlagRequired).Required())
+		test.Assert(t, !NewFlag("long", "", nonNilValuer, "").(FlagHidden).Hidden())
+		test.Assert(t, NewFlag("long", "", nonNilValuer, "", WithHidden()).(FlagHidden).Hidden())
+		test.Assert(t, NewFlag("long", "", nonNilValuer, "").(FlagHelpGroup).HelpGroup() == "")
+		test.Assert(t, NewFlag("long", "", nonNilValuer, "", WithHelpGroup("Networking")).(FlagHelpGroup).HelpGroup() == "Networking")
+		test.Assert(t, NewFlag("long", "", nonNilValuer, "").(FlagDeprecated).Deprecation() == "")
+		test.Assert(t, NewFlag("long", "", nonNilValuer, "", WithDeprecated("use --new")).(FlagDeprecated).Deprecation() == "use --new")
+		test.Assert(t, len(NewFlag("long", "", nonNilValuer, "").(FlagEnv).EnvNames()) == 0)
+		test.Assert(t, slices.Equal(NewFlag("long", "", nonNilValuer, "", WithEnv("A", "B"), WithEnv("C")).(FlagEnv).EnvNames(), []string{"A", "B", "C"}))
+
+		completion := NewFlag("long", "", nonNilValuer, "").(FlagCompletion)
+		candidates, err := completion.Complete(t.Context(), "")
+		test.Assert(t, !completion.CompletesValues() && err == nil && candidates == nil)
+
+		completion = NewFlag("long", "", nonNilValuer, "", WithCompletionValues("a", "b")).(FlagCompletion)
+		candidates, err = completion.Complete(t.Context(), "")
+		test.Assert(t, completion.CompletesValues() && err == nil && slices.Equal(candidates, []string{"a", "b"}))
+	})
+
 	t.Run("wrong setup", func(t *testing.T) {
 		t.Run("short and long names are unset", func(t *testing.T) {
 			test.Assert(check.Panics(t, func() {
@@ -46,6 +68,30 @@ func Test_NewFlag(t *testing.T) {
 			}))
 		})
 
+		t.Run("empty environment variable name", func(t *testing.T) {
+			test.Assert(check.Panics(t, func() {
+				NewFlag("long", "", nonNilValuer, "", WithEnv("A", ""))
+			}, func(reason any) error {
+				if strings.Contains(reason.(string), "environment variable names must be non-empty") {
+					return nil
+				}
+
+				return errors.New("expected different panic reason")
+			}))
+		})
+
+		t.Run("nil completion", func(t *testing.T) {
+			test.Assert(check.Panics(t, func() {
+				NewFlag("long", "", nonNilValuer, "", WithCompletion(nil))
+			}, func(reason any) error {
+				if strings.Contains(reason.(string), "complete is nil") {
+					return nil
+				}
+
+				return errors.New("expected different panic reason")
+			}))
+		})
+
 		t.Run("nil valuer", func(t *testing.T) {
 			test.Assert(check.Panics(t, func() {
 				NewFlag("long", "", nil, "")
@@ -83,3 +129,43 @@ func Test_flagValue_Description(t *testing.T) {
 		description: "description",
 	}.Description() == "description")
 }
+
+func Test_flagValue_Source(t *testing.T) {
+	dest := 1
+
+	flag := NewBuiltinFlag("port", "p", &dest, "")
+	test.Assert(t, GetFlagSource(flag) == "")
+
+	err := flag.(FlagEnv).FromEnv("APP_PORT", "invalid")
+	test.Require(t, err != nil)
+	test.Assert(t, err.Error() == `environment variable APP_PORT of flag --port: strconv.ParseInt: parsing "invalid": invalid syntax`, err.Error())
+	test.Assert(t, GetFlagSource(flag) == "" && !flag.IsSet())
+
+	test.Require(t, flag.(FlagEnv).FromEnv("APP_PORT", "42") == nil)
+	test.Assert(t, dest == 42 && flag.IsSet() && GetFlagSource(flag) == "$APP_PORT")
+
+	test.Require(t, flag.FromString("43") == nil)
+	test.Assert(t, dest == 43 && GetFlagSource(flag) == FlagSourceCommandLine)
+
+	flag.(FlagDefault).ResetToDefault()
+	test.Assert(t, dest == 1 && !flag.IsSet() && GetFlagSource(flag) == "")
+}
+
+func Test_GetFlagSource(t *testing.T) {
+	var level string
+
+	t.Run("wrapped flags", func(t *testing.T) {
+		flag := NewEnumFlag("level", "", &level, []string{"info", "debug"}, "")
+		test.Require(t, flag.(FlagWrapper).Unwrap().(FlagEnv).FromEnv("LEVEL", "info") == nil)
+		test.Assert(t, GetFlagSource(flag) == "$LEVEL")
+	})
+
+	t.Run("flags not knowing their source", func(t *testing.T) {
+		flag := unsourcedFlag{Flag: NewBuiltinFlag("level", "", &level, "")}
+		test.Assert(t, GetFlagSource(flag) == "")
+		test.Require(t, flag.FromString("info") == nil)
+		test.Assert(t, GetFlagSource(flag) == FlagSourceCommandLine)
+	})
+}
+
+type unsourcedFlag struct{ Flag }
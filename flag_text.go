@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"encoding"
+	"fmt"
+)
+
+// textUnmarshalerPointer is satisfied by pointers to T implementing encoding.TextUnmarshaler.
+type textUnmarshalerPointer[T any] interface {
+	*T
+	encoding.TextUnmarshaler
+}
+
+// NewTextFlag creates a Flag for any type whose pointer implements encoding.TextUnmarshaler.
+// When the type also implements encoding.TextMarshaler, it is used to represent the value,
+// otherwise the value is formatted with fmt. See NewFlag for more details.
+//
+// Example:
+//
+//	var level slog.Level
+//	cli.NewTextFlag("log-level", "l", &level, "Log level")
+func NewTextFlag[T any, PT textUnmarshalerPointer[T]](longName, shortName string, destination *T, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, NewFlagValuer(destination, textFromString[T, PT], textToString[T]), description, opts...)
+}
+
+// NewTextPointerFlag creates a Flag for pointers to types implementing encoding.TextUnmarshaler.
+// See NewTextFlag for more details.
+func NewTextPointerFlag[T any, PT textUnmarshalerPointer[T]](longName, shortName string, destination **T, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newPointerFlagValuer(destination, textFromString[T, PT], textToString[T]), description, opts...)
+}
+
+// NewTextSliceFlag creates a Flag for slices of types implementing encoding.TextUnmarshaler.
+// The flag value is expected to be a comma-separated list of values.
+// See NewTextFlag for more details.
+func NewTextSliceFlag[T any, PT textUnmarshalerPointer[T]](longName, shortName string, destination *[]T, description string, opts ...FlagOption) Flag {
+	return NewFlag(longName, shortName, newSliceFlagValuer(destination, textFromString[T, PT], textToString[T]), description, opts...)
+}
+
+// textFromString decodes a value using its encoding.TextUnmarshaler implementation.
+func textFromString[T any, PT textUnmarshalerPointer[T]](raw string) (T, error) {
+	var value T
+
+	if err := PT(&value).UnmarshalText([]byte(raw)); err != nil {
+		return value, err
+	}
+
+	return value, nil
+}
+
+// textToString represents a value using its encoding.TextMarshaler implementation, if any.
+func textToString[T any](value T) string {
+	marshaler, ok := any(value).(encoding.TextMarshaler)
+	if !ok {
+		marshaler, ok = any(&value).(encoding.TextMarshaler)
+	}
+
+	if ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+)
+
+type testTextUnmarshaler struct{ a, b string }
+
+func (v *testTextUnmarshaler) UnmarshalText(text []byte) error {
+	a, b, found := strings.Cut(string(text), ":")
+	if !found {
+		return errors.New("missing colon")
+	}
+
+	v.a, v.b = a, b
+
+	return nil
+}
+
+func Test_NewTextFlag(t *testing.T) {
+	t.Run("with text marshaler", func(t *testing.T) {
+		var value slog.Level
+
+		flag := NewTextFlag("longName", "s", &value, "description")
+		test.Assert(t, flag.LongName() == "longName" && flag.ShortName() == "s" && flag.Description() == "description")
+		test.Assert(t, flag.TypeRepr() == "slog.Level")
+		test.Assert(t, flag.String() == "INFO")
+
+		err := flag.FromString("verbose")
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "unknown name"), err)
+
+		test.Assert(t, flag.FromString(" warn ") == nil)
+		test.Assert(t, value == slog.LevelWarn)
+		test.Assert(t, flag.String() == "WARN")
+	})
+
+	t.Run("without text marshaler", func(t *testing.T) {
+		var value testTextUnmarshaler
+
+		flag := NewTextFlag("longName", "", &value, "")
+		test.Assert(t, flag.FromString("nope") != nil)
+		test.Assert(t, flag.FromString("foo:bar") == nil)
+		test.Assert(t, value.a == "foo" && value.b == "bar")
+		test.Assert(t, flag.String() == "{foo bar}")
+	})
+}
+
+func Test_NewTextPointerFlag(t *testing.T) {
+	var value *slog.Level
+
+	flag := NewTextPointerFlag("longName", "", &value, "")
+	test.Assert(t, flag.TypeRepr() == "*slog.Level")
+	test.Assert(t, flag.String() == "<nil>")
+
+	test.Assert(t, flag.FromString("debug") == nil)
+	test.Assert(t, value != nil && *value == slog.LevelDebug)
+	test.Assert(t, flag.String() == "DEBUG")
+}
+
+func Test_NewTextSliceFlag(t *testing.T) {
+	var value []slog.Level
+
+	flag := NewTextSliceFlag("longName", "", &value, "")
+	test.Assert(t, flag.TypeRepr() == "[]slog.Level")
+
+	test.Assert(t, flag.FromString("debug,nope") != nil)
+	test.Assert(t, flag.FromString("debug, error") == nil)
+	test.Assert(t, len(value) == 2 && value[0] == slog.LevelDebug && value[1] == slog.LevelError)
+	test.Assert(t, flag.String() == "[DEBUG,ERROR]")
+}
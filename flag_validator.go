@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+)
+
+// FlagValidators can be implemented by flags whose values must respect constraints.
+// Mappers run the validators once the command hooks ran, against the final values of the flags,
+// default values included, along with the required flags checks, and report all the violations at once.
+type FlagValidators interface{ Validators() []Validator }
+
+// Validator checks the value of a flag once parsed.
+type Validator interface {
+	// Validate returns an error if the value pointed by destination, as returned
+	// by FlagValuer.Destination, does not respect the constraint.
+	Validate(destination any) error
+
+	// Constraint returns a short description of the constraint, like "1-65535".
+	// This is used in help and documentation. May be empty.
+	Constraint() string
+}
+
+// WithValidators adds validators to the flag, checking its final value, which can be its default value.
+// Validators failures are reported in an error requesting the help to be shown.
+// It panics if one of the built-in validators cannot validate the values of the flag, like InRange(1, 65535) for an uint16 flag.
+func WithValidators(validators ...Validator) FlagOption {
+	return func(f *flagValue) {
+		for _, v := range validators {
+			if checker, ok := v.(destinationChecker); ok {
+				if err := checker.checkDestination(f.Destination()); err != nil {
+					panic(fmt.Sprintf("invalid validator for flag %s: %v", f.name(), err))
+				}
+			}
+		}
+
+		f.validators = append(f.validators, validators...)
+	}
+}
+
+// destinationChecker is implemented by the built-in validators to report, when the flag is created,
+// that they cannot validate its values.
+type destinationChecker interface{ checkDestination(destination any) error }
+
+// NewValidator creates a Validator for values of type T, using validate to check them.
+// Destinations of type *T, **T (nil values being valid) and *[]T (each value being checked) are handled.
+// The constraint describes the validated constraint in help, and can be empty.
+//
+// Example:
+//
+//	cli.NewValidator("even", func(i int) error {
+//	    if i%2 != 0 {
+//	        return errors.New("must be even")
+//	    }
+//	    return nil
+//	})
+func NewValidator[T any](constraint string, validate func(T) error) Validator {
+	if validate == nil {
+		panic("validate is nil")
+	}
+
+	return &validator[T]{constraint: constraint, validate: validate}
+}
+
+// InRange creates a Validator checking that values are between minValue and maxValue, both included.
+// The type of the bounds must match the type of the flag, like InRange[uint16](1, 65535).
+func InRange[T cmp.Ordered](minValue, maxValue T) Validator {
+	return NewValidator(fmt.Sprintf("%v-%v", minValue, maxValue), func(value T) error {
+		if value < minValue || value > maxValue {
+			return fmt.Errorf("%v is not between %v and %v", value, minValue, maxValue)
+		}
+
+		return nil
+	})
+}
+
+// MatchRegexp creates a Validator checking that string values match the provided pattern.
+func MatchRegexp(pattern *regexp.Regexp) Validator {
+	if pattern == nil {
+		panic("pattern is nil")
+	}
+
+	return NewValidator("matching "+pattern.String(), func(value string) error {
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("%q does not match %s", value, pattern)
+		}
+
+		return nil
+	})
+}
+
+// LengthBetween creates a Validator checking that string values have between minLength
+// and maxLength characters, both included.
+func LengthBetween(minLength, maxLength int) Validator {
+	return NewValidator(fmt.Sprintf("%d-%d characters", minLength, maxLength), func(value string) error {
+		if length := utf8.RuneCountInString(value); length < minLength || length > maxLength {
+			return fmt.Errorf("%q has %d characters, must be between %d and %d", value, length, minLength, maxLength)
+		}
+
+		return nil
+	})
+}
+
+// NotEmpty creates a Validator checking that strings, slices and maps are not empty.
+func NotEmpty() Validator { return notEmptyValidator{} }
+
+type validator[T any] struct {
+	constraint string
+	validate   func(T) error
+}
+
+func (v *validator[T]) Constraint() string { return v.constraint }
+
+func (v *validator[T]) Validate(destination any) error {
+	switch dest := destination.(type) {
+	case *T:
+		return v.validate(*dest)
+	case **T:
+		if *dest == nil {
+			return nil
+		}
+
+		return v.validate(**dest)
+	case *[]T:
+		errs := make([]error, len(*dest))
+		for i, value := range *dest {
+			errs[i] = v.validate(value)
+		}
+
+		return errors.Join(errs...)
+	default:
+		return v.checkDestination(destination)
+	}
+}
+
+func (v *validator[T]) checkDestination(destination any) error {
+	switch destination.(type) {
+	case *T, **T, *[]T:
+		return nil
+	default:
+		return fmt.Errorf("unable to validate %T values with a %T validator", destination, v)
+	}
+}
+
+type notEmptyValidator struct{}
+
+func (notEmptyValidator) Constraint() string { return "non-empty" }
+
+func (notEmptyValidator) Validate(destination any) error {
+	value := reflect.ValueOf(destination)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		if value.Len() == 0 {
+			return errors.New("must not be empty")
+		}
+
+		return nil
+	case reflect.Pointer, reflect.Invalid:
+		return errors.New("must not be empty")
+	default:
+		return fmt.Errorf("unable to check emptiness of %s values", value.Type())
+	}
+}
+
+func (notEmptyValidator) checkDestination(destination any) error {
+	typ := reflect.TypeOf(destination)
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == nil {
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return nil
+	default:
+		return fmt.Errorf("unable to check emptiness of %s values", typ)
+	}
+}
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+)
+
+func Test_WithValidators(t *testing.T) {
+	var port int
+
+	validators := []Validator{InRange(1, 65535), InRange(1024, 49151)}
+
+	flag := NewBuiltinFlag("port", "p", &port, "", WithValidators(validators[0]), WithValidators(validators[1]))
+	got := flag.(FlagValidators).Validators()
+	test.Assert(t, len(got) == 2 && got[0] == validators[0] && got[1] == validators[1])
+	test.Assert(t, len(NewBuiltinFlag("port", "p", &port, "").(FlagValidators).Validators()) == 0)
+
+	t.Run("validator of another type", func(t *testing.T) {
+		var value uint16
+
+		for name, validator := range map[string]Validator{
+			"in range":  InRange(1, 65535),
+			"not empty": NotEmpty(),
+		} {
+			t.Run(name, func(t *testing.T) {
+				test.Assert(check.Panics(t, func() {
+					NewBuiltinFlag("port", "p", &value, "", WithValidators(validator))
+				}, func(reason any) error {
+					if strings.HasPrefix(reason.(string), "invalid validator for flag --port: unable to") {
+						return nil
+					}
+
+					return errors.New("expected different panic reason")
+				}))
+			})
+		}
+	})
+
+	t.Run("custom validators are not checked", func(t *testing.T) {
+		var value uint16
+
+		validator := validatorFunc(func(any) error { return nil })
+		test.Assert(t, len(NewBuiltinFlag("port", "p", &value, "", WithValidators(validator)).(FlagValidators).Validators()) == 1)
+	})
+}
+
+type validatorFunc func(destination any) error
+
+func (validate validatorFunc) Validate(destination any) error { return validate(destination) }
+func (validatorFunc) Constraint() string                      { return "" }
+
+func Test_NewValidator(t *testing.T) {
+	even := NewValidator("even", func(i int) error {
+		if i%2 != 0 {
+			return errors.New("must be even")
+		}
+
+		return nil
+	})
+	test.Assert(t, even.Constraint() == "even")
+
+	t.Run("value", func(t *testing.T) {
+		value := 2
+		test.Assert(t, even.Validate(&value) == nil)
+
+		value = 3
+		test.Assert(t, even.Validate(&value) != nil)
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		var value *int
+		test.Assert(t, even.Validate(&value) == nil)
+
+		value = new(int)
+		*value = 3
+		test.Assert(t, even.Validate(&value) != nil)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		values := []int{2, 3, 4, 5}
+
+		err := even.Validate(&values)
+		test.Assert(t, err != nil && err.Error() == "must be even\nmust be even", err)
+	})
+
+	t.Run("unhandled type", func(t *testing.T) {
+		var value string
+
+		err := even.Validate(&value)
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "unable to validate *string values"), err)
+	})
+
+	t.Run("nil validate", func(t *testing.T) {
+		test.Assert(check.Panics(t, func() { NewValidator[int]("", nil) }, nil))
+	})
+}
+
+func Test_builtinValidators(t *testing.T) {
+	for name, tc := range map[string]struct {
+		validator          Validator
+		valid              any
+		invalid            any
+		expectedConstraint string
+		expectedErr        string
+	}{
+		"in range": {
+			validator:          InRange[uint16](1, 1024),
+			valid:              ptr[uint16](80),
+			invalid:            ptr[uint16](8080),
+			expectedConstraint: "1-1024",
+			expectedErr:        "8080 is not between 1 and 1024",
+		},
+		"match regexp": {
+			validator:          MatchRegexp(regexp.MustCompile(`^[a-z]+$`)),
+			valid:              ptr("abc"),
+			invalid:            ptr("ABC"),
+			expectedConstraint: "matching ^[a-z]+$",
+			expectedErr:        `"ABC" does not match ^[a-z]+$`,
+		},
+		"length between": {
+			validator:          LengthBetween(2, 3),
+			valid:              ptr("été"),
+			invalid:            ptr("a"),
+			expectedConstraint: "2-3 characters",
+			expectedErr:        `"a" has 1 characters, must be between 2 and 3`,
+		},
+		"not empty slice": {
+			validator:          NotEmpty(),
+			valid:              ptr([]string{""}),
+			invalid:            ptr([]string{}),
+			expectedConstraint: "non-empty",
+			expectedErr:        "must not be empty",
+		},
+		"not empty map": {
+			validator:          NotEmpty(),
+			valid:              ptr(map[string]int{"a": 1}),
+			invalid:            new(map[string]int),
+			expectedConstraint: "non-empty",
+			expectedErr:        "must not be empty",
+		},
+		"not empty pointer": {
+			validator:          NotEmpty(),
+			valid:              ptr(ptr("a")),
+			invalid:            new(*string),
+			expectedConstraint: "non-empty",
+			expectedErr:        "must not be empty",
+		},
+		"not empty unhandled type": {
+			validator:          NotEmpty(),
+			valid:              ptr(""),
+			invalid:            ptr(42),
+			expectedConstraint: "non-empty",
+			expectedErr:        "unable to check emptiness of int values",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.Assert(t, tc.validator.Constraint() == tc.expectedConstraint, tc.validator.Constraint())
+
+			if name != "not empty unhandled type" {
+				test.Assert(t, tc.validator.Validate(tc.valid) == nil)
+			}
+
+			err := tc.validator.Validate(tc.invalid)
+			test.Assert(t, err != nil && err.Error() == tc.expectedErr, err)
+		})
+	}
+}
+
+func ptr[T any](value T) *T { return &value }
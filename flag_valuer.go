@@ -2,6 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"maps"
+	"reflect"
+	"slices"
 	"strings"
 )
 
@@ -37,6 +40,19 @@ type FlagValuer interface {
 	TypeRepr() string
 }
 
+// FlagDefault can be implemented by valuers remembering the default value of their
+// destination, which is the value it holds when the valuer is created.
+type FlagDefault interface {
+	// DefaultString returns the string representation of the default value.
+	// This is used for displaying the flag's default value in help text.
+	DefaultString() string
+
+	// ResetToDefault sets the destination back to its default value, and marks
+	// the flag as not set. This allows mappers to execute the same commands
+	// multiple times, without values leaking from one execution to the next.
+	ResetToDefault()
+}
+
 // NewFlagValuer creates a new FlagValuer instance for any type.
 // It provides a generic implementation of the FlagValuer interface that can
 // work with any Go type.
@@ -46,7 +62,9 @@ type FlagValuer interface {
 //   - parse: Function to parse a string into the destination type
 //   - toString: Function to convert the destination type to a string
 //
-// Returns a FlagValuer that manages the value at destination.
+// Returns a FlagValuer that manages the value at destination. The value held by
+// destination when calling this function is remembered as the default value, see FlagDefault.
+// Slices and maps are copied, so that setting the flag does not modify its default value.
 //
 // It panics if any of the parameters is nil.
 //
@@ -72,9 +90,10 @@ func NewFlagValuer[T any](destination *T, parse func(string) (T, error), toStrin
 	}
 
 	return &flagValuer[T]{
-		value:    destination,
-		parse:    parse,
-		toString: toString,
+		value:        destination,
+		defaultValue: cloneValue(*destination),
+		parse:        parse,
+		toString:     toString,
 	}
 }
 
@@ -99,14 +118,101 @@ func NewStringerFlagValuer[T fmt.Stringer](destination *T, parse func(string) (T
 	return NewFlagValuer(destination, parse, func(t T) string { return t.String() })
 }
 
+// newPointerFlagValuer creates a FlagValuer for pointers, using parse and toString
+// to handle the pointed value. A new value is allocated each time the flag is set.
+func newPointerFlagValuer[T any](destination **T, parse func(string) (T, error), toString func(T) string) FlagValuer {
+	return NewFlagValuer(destination,
+		func(raw string) (*T, error) {
+			value, err := parse(raw)
+			if err != nil {
+				return nil, err
+			}
+
+			return &value, nil
+		},
+		func(value *T) string {
+			if value != nil {
+				return toString(*value)
+			}
+
+			return "<nil>"
+		},
+	)
+}
+
+// newMapFlagValuer creates a FlagValuer for maps, using the parse and toString functions
+// to handle each key and value. The raw value is expected to be a comma-separated list of
+// key=value entries. The destination is reset the first time the value is set, then each
+// new raw value adds its entries to the map, until the valuer is reset to its default.
+func newMapFlagValuer[K comparable, V any](
+	destination *map[K]V,
+	parseKey func(string) (K, error), parseValue func(string) (V, error),
+	keyToString func(K) string, valueToString func(V) string,
+) FlagValuer {
+	var valuer FlagValuer
+
+	valuer = NewFlagValuer(destination,
+		func(raw string) (map[K]V, error) {
+			values := make(map[K]V)
+			if valuer.IsSet() {
+				maps.Copy(values, *destination)
+			}
+
+			for rawEntry := range strings.SplitSeq(raw, ",") {
+				rawKey, rawValue, found := strings.Cut(rawEntry, "=")
+				if !found {
+					return nil, fmt.Errorf("invalid map entry %q: expected key=value", rawEntry)
+				}
+
+				key, err := parseKey(strings.TrimSpace(rawKey))
+				if err != nil {
+					return nil, fmt.Errorf("invalid map key %q: %w", rawKey, err)
+				}
+
+				value, err := parseValue(strings.TrimSpace(rawValue))
+				if err != nil {
+					return nil, fmt.Errorf("invalid map value %q for key %q: %w", rawValue, rawKey, err)
+				}
+
+				values[key] = value
+			}
+
+			return values, nil
+		},
+		func(values map[K]V) string {
+			entries := make([]string, 0, len(values))
+			for key, value := range values {
+				entries = append(entries, keyToString(key)+"="+valueToString(value))
+			}
+
+			slices.Sort(entries)
+
+			return strings.Join(entries, ",")
+		},
+	)
+
+	return valuer
+}
+
+// sliceToString converts a slice to its string representation, using toString for each value.
+func sliceToString[T any](values []T, toString func(T) string) string {
+	valuesRepr := make([]string, len(values))
+	for i, value := range values {
+		valuesRepr[i] = toString(value)
+	}
+
+	return "[" + strings.Join(valuesRepr, ",") + "]"
+}
+
 // flagValuer is a generic implementation of the FlagValuer interface.
-// It handles the conversion between string and typed values, and tracks
-// whether the flag has been set.
+// It handles the conversion between string and typed values, tracks
+// whether the flag has been set, and remembers its default value.
 type flagValuer[T any] struct {
-	value    *T
-	changed  bool
-	parse    func(string) (T, error)
-	toString func(T) string
+	value        *T
+	defaultValue T
+	changed      bool
+	parse        func(string) (T, error)
+	toString     func(T) string
 }
 
 // Destination returns the pointer to the flag's value.
@@ -135,3 +241,55 @@ func (v *flagValuer[T]) String() string { return v.toString(*v.value) }
 
 // TypeRepr returns a string representation of the flag's type.
 func (v *flagValuer[T]) TypeRepr() string { return fmt.Sprintf("%T", *v.value) }
+
+// DefaultString returns the string representation of the flag's default value.
+func (v *flagValuer[T]) DefaultString() string { return v.toString(v.defaultValue) }
+
+// ResetToDefault sets the flag's value back to its default value, and marks it as not set.
+func (v *flagValuer[T]) ResetToDefault() {
+	*v.value = cloneValue(v.defaultValue)
+	v.changed = false
+}
+
+// cloneValue returns a copy of the provided value, which does not share its storage for slices and maps.
+func cloneValue[T any](value T) T {
+	rv := reflect.ValueOf(&value).Elem()
+
+	switch rv.Kind() {
+	case reflect.Slice:
+		if !rv.IsNil() {
+			clone := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+			reflect.Copy(clone, rv)
+			rv.Set(clone)
+		}
+	case reflect.Map:
+		if !rv.IsNil() {
+			clone := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+			for iter := rv.MapRange(); iter.Next(); {
+				clone.SetMapIndex(iter.Key(), iter.Value())
+			}
+
+			rv.Set(clone)
+		}
+	default:
+	}
+
+	return value
+}
+
+// valuerDefaultString returns the default value of the valuer if it implements FlagDefault,
+// its current value otherwise.
+func valuerDefaultString(valuer FlagValuer) string {
+	if defaulter, ok := valuer.(FlagDefault); ok {
+		return defaulter.DefaultString()
+	}
+
+	return valuer.String()
+}
+
+// resetValuerToDefault resets the valuer to its default value if it implements FlagDefault.
+func resetValuerToDefault(valuer FlagValuer) {
+	if defaulter, ok := valuer.(FlagDefault); ok {
+		defaulter.ResetToDefault()
+	}
+}
@@ -67,6 +67,77 @@ func Test_NewFlagValuer(t *testing.T) {
 	})
 }
 
+func Test_flagValuer_default(t *testing.T) {
+	t.Run("builtin", func(t *testing.T) {
+		s := "default"
+
+		flag := NewBuiltinFlag("a", "", &s, "")
+		test.Assert(t, flag.(FlagDefault).DefaultString() == "default")
+
+		test.Require(t, flag.FromString("") == nil)
+		test.Assert(t, s == "" && flag.IsSet())
+		test.Assert(t, flag.(FlagDefault).DefaultString() == "default")
+
+		flag.(FlagDefault).ResetToDefault()
+		test.Assert(t, s == "default" && !flag.IsSet())
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		values := []int{1}
+
+		flag := NewBuiltinSliceFlag("a", "", &values, "", WithAppend(), WithResetDefault())
+		test.Require(t, flag.FromString("2") == nil && flag.FromString("3") == nil)
+		test.Assert(check.Compare(t, values, []int{2, 3}))
+		test.Assert(t, flag.(FlagDefault).DefaultString() == "[1]")
+
+		flag.(FlagDefault).ResetToDefault()
+		test.Assert(check.Compare(t, values, []int{1}))
+
+		test.Require(t, flag.FromString("4") == nil)
+		test.Assert(check.Compare(t, values, []int{4}))
+	})
+
+	t.Run("map", func(t *testing.T) {
+		values := map[string]int{"a": 1}
+
+		flag := NewBuiltinMapFlag("a", "", &values, "")
+		test.Require(t, flag.FromString("b=2") == nil && flag.FromString("c=3") == nil)
+		test.Assert(check.Compare(t, values, map[string]int{"b": 2, "c": 3}))
+		test.Assert(t, flag.(FlagDefault).DefaultString() == "a=1")
+
+		flag.(FlagDefault).ResetToDefault()
+		test.Assert(check.Compare(t, values, map[string]int{"a": 1}))
+
+		test.Require(t, flag.FromString("d=4") == nil)
+		test.Assert(check.Compare(t, values, map[string]int{"d": 4}))
+	})
+
+	t.Run("flag created anew", func(t *testing.T) {
+		s := "default"
+
+		test.Require(t, NewBuiltinFlag("a", "", &s, "").FromString("first") == nil)
+
+		flag := NewBuiltinFlag("a", "", &s, "")
+		test.Assert(t, flag.(FlagDefault).DefaultString() == "first")
+
+		s = "assigned"
+		test.Assert(t, NewBuiltinFlag("a", "", &s, "").(FlagDefault).DefaultString() == "assigned")
+	})
+
+	t.Run("default does not share storage", func(t *testing.T) {
+		values := []int{1}
+
+		flag := NewBuiltinSliceFlag("a", "", &values, "")
+		values[0] = 2
+		test.Assert(t, flag.(FlagDefault).DefaultString() == "[1]")
+
+		flag.(FlagDefault).ResetToDefault()
+		values[0] = 3
+		flag.(FlagDefault).ResetToDefault()
+		test.Assert(check.Compare(t, values, []int{1}))
+	})
+}
+
 func Test_NewStringerFlagValuer(t *testing.T) {
 	t.Run("non nil underlying value", func(t *testing.T) {
 		var d time.Duration
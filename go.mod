@@ -7,6 +7,7 @@ require (
 	github.com/krostar/test v1.0.1
 	github.com/spf13/cobra v1.9.1
 	github.com/spf13/pflag v1.0.7
+	github.com/urfave/cli/v3 v3.14.0
 	go.uber.org/dig v1.19.0
 	gopkg.in/yaml.v3 v3.0.1
 )
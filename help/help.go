@@ -0,0 +1,355 @@
+// Package clihelp renders the help of commands, whatever the mapper used to execute them.
+//
+// Mappers delegate the rendering of help to this package, so that the help of a CLI is the same whatever
+// the backend. Help describes the usage, arguments, aliases, examples, subcommands and flags of a command,
+// flags being listed by group (see cli.WithHelpGroup), and the flags inherited from the parent commands last.
+//
+// Help is rendered by a text/template, DefaultTemplate by default, which can be replaced with WithTemplate.
+// The data of templates is Data, and the following functions are available to them:
+//
+//	heading TEXT        TEXT in bold, when colors are enabled
+//	highlight TEXT      TEXT in cyan, when colors are enabled
+//	wrap INDENT TEXT    TEXT wrapped to fit the width once indented by INDENT spaces
+//	indent INDENT TEXT  TEXT with its lines indented by INDENT spaces
+//	table ITEMS         ITEMS aligned in two columns, the descriptions being wrapped
+//	join ELEMS SEP      ELEMS joined with SEP, see strings.Join
+//
+// When help is written to a terminal, it is wrapped to the width of the terminal, and colored unless
+// the NO_COLOR environment variable is set. This can be changed with WithWidth and WithColor.
+// As this package only depends on the standard library, the width of the terminal is read from the
+// COLUMNS environment variable, unless a function querying the terminal is provided with WithTerminalWidth.
+//
+// Options are provided to the mappers with the context used to execute the CLI, see NewContextWithOptions.
+package clihelp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
+)
+
+// DefaultTemplate is the template used to render help by default.
+const DefaultTemplate = `{{with .Description}}{{wrap 0 .}}
+
+{{end}}{{heading "Usage:"}}
+{{range .Usage}}  {{.}}
+{{end}}{{with .Args}}
+{{heading "Arguments:"}}
+{{table .}}{{end}}{{with .Aliases}}
+{{heading "Aliases:"}}
+  {{join . ", "}}
+{{end}}{{with .Examples}}
+{{heading "Examples:"}}
+{{range .}}{{indent 2 .}}
+{{end}}{{end}}{{with .SubCommands}}
+{{heading "Available Commands:"}}
+{{table .}}{{end}}{{range .FlagGroups}}
+{{heading (print .Title ":")}}
+{{table .Flags}}{{end}}{{if .SubCommands}}
+Use "{{.Name}} [command] --help" for more information about a command.
+{{end}}`
+
+// Command is a command whose help is rendered.
+type Command struct {
+	// Path is the names of the commands from the root command to the command, like ["app", "serve"].
+	Path []string
+	// CLI is the command.
+	CLI *cli.CLI
+	// Flags are the local and persistent flags of the command.
+	Flags []cli.Flag
+	// InheritedFlags are the persistent flags of the parents of the command, the nearest parent first.
+	// Inherited flags whose names or aliases are already used are not listed, as they are shadowed.
+	InheritedFlags []cli.Flag
+}
+
+// Data is the data used by templates to render the help of a command.
+type Data struct {
+	// Name is the full name of the command, like "app serve".
+	Name string
+	// Description is the description of the command.
+	Description string
+	// Usage are the ways to call the command, like "app serve [flags] <target>".
+	Usage []string
+	// Args are the positional and dashed arguments of the command.
+	Args []Item
+	// Aliases are the name and the aliases of the command, for commands having aliases.
+	Aliases []string
+	// Examples are the examples of the command.
+	Examples []string
+	// SubCommands are the visible subcommands of the command.
+	SubCommands []Item
+	// FlagGroups are the visible flags of the command: the ungrouped flags of the command first, with the
+	// help flag, then one group for each help group, and the ungrouped inherited flags last.
+	FlagGroups []FlagGroup
+}
+
+// Item is an entry of a table, like an argument, a subcommand or a flag.
+type Item struct {
+	Name        string
+	Description string
+}
+
+// FlagGroup is a group of flags, listed in a section of their own.
+type FlagGroup struct {
+	Title string
+	Flags []Item
+}
+
+// Option defines options to customize the rendering of help.
+type Option func(*options)
+
+// WithTemplate replaces the template used to render help, see DefaultTemplate.
+func WithTemplate(text string) Option {
+	return func(o *options) { o.template = text }
+}
+
+// WithWidth sets the width help is wrapped to, which is the width of the terminal by default.
+// A width of 0 disables wrapping.
+func WithWidth(width int) Option {
+	return func(o *options) { o.width = &width }
+}
+
+// WithColor enables or disables colors, which are enabled by default when help is written to a terminal.
+func WithColor(enabled bool) Option {
+	return func(o *options) { o.color = &enabled }
+}
+
+// WithTerminalWidth sets the function returning the width of the terminal referred to by the provided
+// file descriptor, used when help is written to a terminal and no width is set with WithWidth.
+//
+// Example:
+//
+//	clihelp.WithTerminalWidth(func(fd uintptr) (int, error) {
+//		width, _, err := term.GetSize(int(fd)) // golang.org/x/term
+//		return width, err
+//	})
+func WithTerminalWidth(terminalWidth func(fd uintptr) (int, error)) Option {
+	return func(o *options) { o.terminalWidth = terminalWidth }
+}
+
+type options struct {
+	template      string
+	width         *int
+	color         *bool
+	terminalWidth func(fd uintptr) (int, error)
+}
+
+type ctxKey uint8
+
+const ctxKeyOptions ctxKey = iota + 1
+
+// NewContextWithOptions returns a context holding the provided options, added to the ones the
+// provided context may hold. Mappers executed with this context render help with these options.
+//
+// Example:
+//
+//	ctx = clihelp.NewContextWithOptions(ctx, clihelp.WithTemplate(helpTemplate))
+//	err := spf13cobra.Execute(ctx, os.Args, cmd)
+func NewContextWithOptions(ctx context.Context, opts ...Option) context.Context {
+	return context.WithValue(ctx, ctxKeyOptions, slices.Concat(optionsFromContext(ctx), opts))
+}
+
+func optionsFromContext(ctx context.Context) []Option {
+	opts, _ := ctx.Value(ctxKeyOptions).([]Option) //nolint:revive,errcheck // unchecked-type-assertion: zero value is fine
+	return opts
+}
+
+// Write writes the help of the provided command. The options held by the context, see NewContextWithOptions,
+// are applied before the provided ones.
+func Write(ctx context.Context, w io.Writer, cmd Command, opts ...Option) error {
+	o := options{template: DefaultTemplate}
+	for _, opt := range slices.Concat(optionsFromContext(ctx), opts) {
+		opt(&o)
+	}
+
+	width, isTerminal := terminal(w, o.terminalWidth)
+	if o.width != nil {
+		width = *o.width
+	}
+
+	color := isTerminal && os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb"
+	if o.color != nil {
+		color = *o.color
+	}
+
+	tmpl, err := template.New("help").Funcs(newRenderer(width, color).funcs()).Parse(o.template)
+	if err != nil {
+		return fmt.Errorf("unable to parse help template: %w", err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, newData(cmd)); err != nil {
+		return fmt.Errorf("unable to render help: %w", err)
+	}
+
+	_, err = io.WriteString(w, b.String())
+
+	return err
+}
+
+// defaultTerminalWidth is the width of terminals whose width is unknown.
+const defaultTerminalWidth = 80
+
+// terminal returns the width of the terminal the provided writer writes to, if any. The width is the one
+// returned by terminalWidth if provided, the one set by the COLUMNS environment variable otherwise.
+func terminal(w io.Writer, terminalWidth func(fd uintptr) (int, error)) (int, bool) {
+	file, ok := w.(interface {
+		Fd() uintptr
+		Stat() (os.FileInfo, error)
+	})
+	if !ok {
+		return 0, false
+	}
+
+	if info, err := file.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return 0, false
+	}
+
+	if terminalWidth != nil {
+		if width, err := terminalWidth(file.Fd()); err == nil && width > 0 {
+			return width, true
+		}
+	}
+
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width, true
+	}
+
+	return defaultTerminalWidth, true
+}
+
+func newData(cmd Command) Data {
+	c := cmd.CLI.Command
+	name := strings.Join(cmd.Path, " ")
+
+	data := Data{
+		Name:        name,
+		Description: strings.TrimSpace(mapper.Description(c)),
+		Usage:       []string{strings.TrimSpace(name + " [flags] " + mapper.Usage(c))},
+		Examples:    mapper.Examples(c),
+	}
+
+	if spec := mapper.Args(c); spec != nil {
+		for _, arg := range spec.All() {
+			data.Args = append(data.Args, Item{Name: arg.Name(), Description: arg.Description()})
+		}
+	}
+
+	if aliases := mapper.Aliases(c); len(aliases) > 0 {
+		data.Aliases = append([]string{cmd.Path[len(cmd.Path)-1]}, aliases...)
+	}
+
+	for _, sub := range cmd.CLI.SubCommands {
+		if !mapper.CommandHidden(sub.Command) {
+			data.SubCommands = append(data.SubCommands, Item{Name: sub.Name, Description: mapper.ShortDescription(sub.Command)})
+		}
+	}
+
+	if len(data.SubCommands) > 0 {
+		data.Usage = append(data.Usage, name+" [command]")
+	}
+
+	data.FlagGroups = newFlagGroups(cmd)
+
+	return data
+}
+
+// newFlagGroups returns the groups of the visible flags of the command, see Data.FlagGroups.
+func newFlagGroups(cmd Command) []FlagGroup {
+	var (
+		flags     = FlagGroup{Title: "Flags"}
+		inherited = FlagGroup{Title: "Global Flags"}
+		groups    []FlagGroup
+		used      = make(map[string]bool)
+	)
+
+	add := func(flag cli.Flag, ungrouped *FlagGroup) {
+		if mapper.FlagHidden(flag) {
+			return
+		}
+
+		group := mapper.FlagHelpGroup(flag)
+		if group == "" {
+			ungrouped.Flags = append(ungrouped.Flags, newFlagItem(flag))
+			return
+		}
+
+		i := slices.IndexFunc(groups, func(g FlagGroup) bool { return g.Title == group })
+		if i < 0 {
+			i = len(groups)
+			groups = append(groups, FlagGroup{Title: group})
+		}
+
+		groups[i].Flags = append(groups[i].Flags, newFlagItem(flag))
+	}
+
+	for _, flag := range cmd.Flags {
+		used["--"+flag.LongName()], used["-"+flag.ShortName()] = true, true
+		add(flag, &flags)
+	}
+
+	for _, flag := range mapper.InheritedFlagsNotShadowed(cmd.Flags, cmd.InheritedFlags) {
+		used["--"+flag.LongName()], used["-"+flag.ShortName()] = true, true
+		add(flag, &inherited)
+	}
+
+	var helpName string
+
+	switch {
+	case !used["-h"] && !used["--help"]:
+		helpName = "-h, --help"
+	case !used["-h"]:
+		helpName = "-h"
+	case !used["--help"]:
+		// aligned with the long names of the flags having a short name, like newFlagItem does
+		helpName = "    --help"
+	}
+
+	if helpName != "" {
+		flags.Flags = append(flags.Flags, Item{Name: helpName, Description: "help for " + cmd.Path[len(cmd.Path)-1]})
+	}
+
+	groups = append([]FlagGroup{flags}, groups...)
+	if len(inherited.Flags) > 0 {
+		groups = append(groups, inherited)
+	}
+
+	return slices.DeleteFunc(groups, func(group FlagGroup) bool { return len(group.Flags) == 0 })
+}
+
+// newFlagItem returns the item describing the flag, like "-s, --long type" and its description with its default value.
+func newFlagItem(flag cli.Flag) Item {
+	var names []string
+
+	if shortName := flag.ShortName(); shortName != "" {
+		names = append(names, "-"+shortName)
+	}
+
+	if longName := flag.LongName(); longName != "" {
+		names = append(names, "--"+longName)
+	}
+
+	name := strings.Join(names, ", ")
+	if flag.ShortName() == "" {
+		name = "    " + name
+	}
+
+	if mapper.FlagExpectsValue(flag) {
+		name += " " + flag.TypeRepr()
+	}
+
+	description := mapper.FlagDescription(flag)
+	if defaultValue := mapper.FlagDocumentedDefault(flag); defaultValue != "" {
+		description = strings.TrimSpace(description + " (default " + defaultValue + ")")
+	}
+
+	return Item{Name: name, Description: description}
+}
@@ -0,0 +1,309 @@
+package clihelp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+)
+
+func newCommand() Command {
+	var (
+		verbose bool
+		quiet   bool
+		port    int
+		token   string
+		secret  string
+		output  = "text"
+	)
+
+	root := cli.New(double.NewFake()).
+		AddCommand("serve", double.NewFake(
+			double.FakeWithDescription(func() string { return "serve things" }),
+			double.FakeWithUsage(func() string { return "<address> [-- command...]" }),
+			double.FakeWithArgs(func() *cli.ArgsSpec {
+				return &cli.ArgsSpec{
+					Positional: &cli.ArgList{Args: []cli.Arg{cli.NewArgWithValuer("address", nil, "where to listen")}},
+					Dashed:     &cli.ArgList{Variadic: cli.NewArgWithValuer("command", nil, "command to run")},
+				}
+			}),
+			double.FakeWithAliases(func() []string { return []string{"s"} }),
+			double.FakeWithExamples(func() []string { return []string{"app serve :8080", "app serve :8080 -- echo ok"} }),
+		)).
+		AddCommand("status", double.NewFake(double.FakeWithDescription(func() string { return "display the status\nof things" })))
+
+	serve := root.SubCommands[0].
+		AddCommand("http", double.NewFake()).
+		AddCommand("secret", double.NewFake(), cli.WithHiddenCommand())
+
+	return Command{
+		Path: []string{"app", "serve"},
+		CLI:  serve,
+		Flags: []cli.Flag{
+			cli.NewBuiltinFlag("port", "p", &port, "listen port"),
+			cli.NewBuiltinFlag("token", "", &token, "auth token", cli.WithHelpGroup("Security")),
+			cli.NewBuiltinFlag("secret", "", &secret, "", cli.WithHelpGroup("Security"), cli.WithHidden()),
+			cli.NewEnumFlag("output", "o", &output, []string{"text", "json"}, "output format", cli.WithHelpGroup("Output")),
+		},
+		InheritedFlags: []cli.Flag{
+			cli.NewBuiltinFlag("verbose", "v", &verbose, "more logs"),
+			cli.NewBuiltinFlag("quiet", "q", &quiet, "less logs", cli.WithHelpGroup("Output")),
+			cli.NewBuiltinFlag("port", "", &port, "shadowed port"),
+		},
+	}
+}
+
+func Test_Write(t *testing.T) {
+	t.Run("default template", func(t *testing.T) {
+		output := new(bytes.Buffer)
+		test.Require(t, Write(t.Context(), output, newCommand()) == nil)
+		test.Assert(t, output.String() == `serve things
+
+Usage:
+  app serve [flags] <address> [-- command...]
+  app serve [command]
+
+Arguments:
+  address   where to listen
+  command   command to run
+
+Aliases:
+  serve, s
+
+Examples:
+  app serve :8080
+  app serve :8080 -- echo ok
+
+Available Commands:
+  http
+
+Flags:
+  -p, --port int   listen port
+  -h, --help       help for serve
+
+Security:
+      --token string   auth token
+
+Output:
+  -o, --output {text|json}   output format (default text)
+  -q, --quiet                less logs
+
+Global Flags:
+  -v, --verbose   more logs
+
+Use "app serve [command] --help" for more information about a command.
+`, output.String())
+	})
+
+	t.Run("help flag names in use", func(t *testing.T) {
+		var help, host bool
+
+		output := new(bytes.Buffer)
+		test.Require(t, Write(t.Context(), output, Command{
+			Path:           []string{"app"},
+			CLI:            cli.New(double.NewFake()),
+			Flags:          []cli.Flag{cli.NewBuiltinFlag("host", "h", &host, "listen on host")},
+			InheritedFlags: []cli.Flag{cli.NewBuiltinFlag("help", "", &help, "not the help")},
+		}) == nil)
+		test.Assert(t, output.String() == `Usage:
+  app [flags]
+
+Flags:
+  -h, --host   listen on host
+
+Global Flags:
+      --help   not the help
+`, output.String())
+	})
+
+	t.Run("inherited flags shadowed by aliases", func(t *testing.T) {
+		var (
+			listen, addr string
+			verbose      bool
+			debug        bool
+		)
+
+		output := new(bytes.Buffer)
+		test.Require(t, Write(t.Context(), output, Command{
+			Path:  []string{"app"},
+			CLI:   cli.New(double.NewFake()),
+			Flags: []cli.Flag{cli.NewBuiltinFlag("listen", "", &listen, "listen address", cli.WithAliases("addr"))},
+			InheritedFlags: []cli.Flag{
+				cli.NewBuiltinFlag("addr", "", &addr, "shadowed by an alias of a flag"),
+				cli.NewBuiltinFlag("verbose", "v", &verbose, "alias shadowed by a flag", cli.WithAliases("listen")),
+				cli.NewBuiltinFlag("debug", "", &debug, "debug logs"),
+			},
+		}) == nil)
+		test.Assert(t, output.String() == `Usage:
+  app [flags]
+
+Flags:
+      --listen string   listen address
+  -h, --help            help for app
+
+Global Flags:
+      --debug   debug logs
+`, output.String())
+	})
+
+	t.Run("help short name in use", func(t *testing.T) {
+		var host bool
+
+		output := new(bytes.Buffer)
+		test.Require(t, Write(t.Context(), output, Command{
+			Path:  []string{"app"},
+			CLI:   cli.New(double.NewFake()),
+			Flags: []cli.Flag{cli.NewBuiltinFlag("host", "h", &host, "listen on host")},
+		}) == nil)
+		test.Assert(t, output.String() == `Usage:
+  app [flags]
+
+Flags:
+  -h, --host   listen on host
+      --help   help for app
+`, output.String())
+	})
+
+	t.Run("width", func(t *testing.T) {
+		var port int
+
+		output := new(bytes.Buffer)
+		test.Require(t, Write(t.Context(), output, Command{
+			Path: []string{"app"},
+			CLI: cli.New(double.NewFake(
+				double.FakeWithDescription(func() string { return "a description long enough to be wrapped at the width" }),
+			)),
+			Flags: []cli.Flag{cli.NewBuiltinFlag("port", "p", &port, "the port the server listens on for incoming connections")},
+		}, WithWidth(40)) == nil)
+		test.Assert(t, output.String() == `a description long enough to be wrapped
+at the width
+
+Usage:
+  app [flags]
+
+Flags:
+  -p, --port int   the port the server
+                   listens on for
+                   incoming connections
+  -h, --help       help for app
+`, output.String())
+	})
+
+	t.Run("color", func(t *testing.T) {
+		output := new(bytes.Buffer)
+		test.Require(t, Write(t.Context(), output, Command{Path: []string{"app"}, CLI: cli.New(double.NewFake())}, WithColor(true)) == nil)
+		test.Assert(t, output.String() == "\x1b[1mUsage:\x1b[0m\n  app [flags]\n\n\x1b[1mFlags:\x1b[0m\n  \x1b[36m-h, --help\x1b[0m   help for app\n", output.String())
+	})
+
+	t.Run("custom template", func(t *testing.T) {
+		output := new(bytes.Buffer)
+		test.Require(t, Write(t.Context(), output, newCommand(), WithTemplate(`{{.Name}}:{{range .FlagGroups}} {{.Title}}{{end}}`)) == nil)
+		test.Assert(t, output.String() == "app serve: Flags Security Output Global Flags", output.String())
+	})
+
+	t.Run("options from context", func(t *testing.T) {
+		ctx := NewContextWithOptions(t.Context(), WithTemplate("from context"), WithColor(true))
+		ctx = NewContextWithOptions(ctx, WithTemplate(`{{heading "from context again"}}`))
+
+		output := new(bytes.Buffer)
+		test.Require(t, Write(ctx, output, newCommand()) == nil)
+		test.Assert(t, output.String() == "\x1b[1mfrom context again\x1b[0m", output.String())
+
+		output.Reset()
+		test.Require(t, Write(ctx, output, newCommand(), WithColor(false)) == nil)
+		test.Assert(t, output.String() == "from context again", output.String())
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		err := Write(t.Context(), new(bytes.Buffer), newCommand(), WithTemplate("{{"))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "unable to parse help template"), "%v", err)
+	})
+
+	t.Run("template execution failed", func(t *testing.T) {
+		err := Write(t.Context(), new(bytes.Buffer), newCommand(), WithTemplate("{{.Unknown}}"))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "unable to render help"), "%v", err)
+	})
+
+	t.Run("write failed", func(t *testing.T) {
+		err := Write(t.Context(), failingWriter{}, newCommand())
+		test.Assert(t, errors.Is(err, errWrite), "%v", err)
+	})
+}
+
+func Test_terminal(t *testing.T) {
+	t.Run("not a file", func(t *testing.T) {
+		_, isTerminal := terminal(new(bytes.Buffer), nil)
+		test.Assert(t, !isTerminal)
+	})
+
+	t.Run("not a terminal", func(t *testing.T) {
+		r, w, err := os.Pipe()
+		test.Require(t, err == nil, "%v", err)
+		t.Cleanup(func() { _ = r.Close(); _ = w.Close() })
+
+		_, isTerminal := terminal(w, func(uintptr) (int, error) { return 100, nil })
+		test.Assert(t, !isTerminal)
+	})
+
+	t.Run("terminal", func(t *testing.T) {
+		// the null device is a character device, like terminals
+		file, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+		test.Require(t, err == nil, "%v", err)
+		t.Cleanup(func() { _ = file.Close() })
+
+		for name, tt := range map[string]struct {
+			columns       string
+			terminalWidth func(uintptr) (int, error)
+			expectedWidth int
+		}{
+			"default width":        {expectedWidth: defaultTerminalWidth},
+			"width from env":       {columns: "42", expectedWidth: 42},
+			"invalid width in env": {columns: "wide", expectedWidth: defaultTerminalWidth},
+			"width from function": {
+				columns:       "42",
+				terminalWidth: func(uintptr) (int, error) { return 100, nil },
+				expectedWidth: 100,
+			},
+			"function failed": {
+				columns:       "42",
+				terminalWidth: func(uintptr) (int, error) { return 0, errors.New("boom") },
+				expectedWidth: 42,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				t.Setenv("COLUMNS", tt.columns)
+
+				width, isTerminal := terminal(file, tt.terminalWidth)
+				test.Assert(t, isTerminal)
+				test.Assert(t, width == tt.expectedWidth, "%d", width)
+			})
+		}
+	})
+}
+
+func Test_NewContextWithOptions(t *testing.T) {
+	test.Assert(t, len(optionsFromContext(context.Background())) == 0)
+
+	ctx := NewContextWithOptions(context.Background(), WithWidth(1))
+	ctx = NewContextWithOptions(ctx, WithWidth(2), WithColor(true))
+
+	var o options
+	for _, opt := range optionsFromContext(ctx) {
+		opt(&o)
+	}
+
+	test.Assert(t, o.width != nil && *o.width == 2 && o.color != nil && *o.color)
+}
+
+var errWrite = errors.New("boom")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errWrite }
@@ -0,0 +1,128 @@
+package clihelp
+
+import (
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// minDescriptionWidth is the minimum width of the descriptions of tables: when the terminal is too narrow,
+// descriptions are wrapped to this width.
+const minDescriptionWidth = 20
+
+// renderer provides the functions available to templates.
+type renderer struct {
+	width int
+	color bool
+}
+
+func newRenderer(width int, color bool) *renderer { return &renderer{width: width, color: color} }
+
+func (r *renderer) funcs() template.FuncMap {
+	return template.FuncMap{
+		"heading":   r.heading,
+		"highlight": r.highlight,
+		"wrap":      r.wrap,
+		"indent":    indent,
+		"table":     r.table,
+		"join":      strings.Join,
+	}
+}
+
+// heading returns the text in bold, when colors are enabled.
+func (r *renderer) heading(text string) string { return r.colorize("1", text) }
+
+// highlight returns the text in cyan, when colors are enabled.
+func (r *renderer) highlight(text string) string { return r.colorize("36", text) }
+
+func (r *renderer) colorize(code, text string) string {
+	if !r.color || text == "" {
+		return text
+	}
+
+	return "\x1b[" + code + "m" + text + "\x1b[0m"
+}
+
+// wrap wraps the lines of the text so that they fit the width once indented by the provided number of spaces.
+func (r *renderer) wrap(indentation int, text string) string {
+	if r.width <= 0 {
+		return text
+	}
+
+	return wrap(text, max(r.width-indentation, minDescriptionWidth))
+}
+
+// table returns the items aligned in two columns, indented by two spaces. Descriptions are wrapped
+// to fit the width, their following lines being aligned with their first one.
+func (r *renderer) table(items []Item) string {
+	var nameWidth int
+	for _, item := range items {
+		nameWidth = max(nameWidth, utf8.RuneCountInString(item.Name))
+	}
+
+	column := 2 + nameWidth + 3
+
+	var b strings.Builder
+
+	for _, item := range items {
+		b.WriteString("  " + r.highlight(item.Name))
+
+		if item.Description != "" {
+			padding := strings.Repeat(" ", column-2-utf8.RuneCountInString(item.Name))
+			b.WriteString(padding + strings.TrimLeft(indent(column, r.wrap(column, item.Description)), " "))
+		}
+
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// indent returns the text with its non-empty lines indented by the provided number of spaces.
+func indent(indentation int, text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = strings.Repeat(" ", indentation) + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// wrap wraps the lines of the text longer than the provided width at spaces. Lines being wrapped
+// keep their indentation, and words longer than the width are not split.
+func wrap(text string, width int) string {
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		if utf8.RuneCountInString(line) <= width {
+			continue
+		}
+
+		content := strings.TrimLeft(line, " ")
+		lineIndent := line[:len(line)-len(content)]
+
+		var (
+			wrapped []string
+			current = lineIndent
+		)
+
+		for _, word := range strings.Fields(content) {
+			if current != lineIndent && utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width {
+				wrapped = append(wrapped, current)
+				current = lineIndent
+			}
+
+			if current != lineIndent {
+				current += " "
+			}
+
+			current += word
+		}
+
+		lines[i] = strings.Join(append(wrapped, current), "\n")
+	}
+
+	return strings.Join(lines, "\n")
+}
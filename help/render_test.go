@@ -0,0 +1,41 @@
+package clihelp
+
+import (
+	"testing"
+
+	"github.com/krostar/test"
+)
+
+func Test_renderer_table(t *testing.T) {
+	t.Run("aligned", func(t *testing.T) {
+		table := newRenderer(0, false).table([]Item{{Name: "a", Description: "first"}, {Name: "long name"}, {Name: "b", Description: "last"}})
+		test.Assert(t, table == "  a           first\n  long name\n  b           last\n", table)
+	})
+
+	t.Run("narrow width", func(t *testing.T) {
+		table := newRenderer(10, false).table([]Item{{Name: "name", Description: "a description wrapped to the minimum width"}})
+		test.Assert(t, table == "  name   a description\n         wrapped to the\n         minimum width\n", table)
+	})
+}
+
+func Test_indent(t *testing.T) {
+	test.Assert(t, indent(2, "a\n\n b") == "  a\n\n   b")
+}
+
+func Test_wrap(t *testing.T) {
+	for name, tt := range map[string]struct {
+		text     string
+		width    int
+		expected string
+	}{
+		"short lines":     {text: "a b\nc", width: 3, expected: "a b\nc"},
+		"long line":       {text: "aa bb cc dd", width: 5, expected: "aa bb\ncc dd"},
+		"indented line":   {text: "  aa bb cc", width: 6, expected: "  aa\n  bb\n  cc"},
+		"very long words": {text: "aaaaaa bbbbbb", width: 4, expected: "aaaaaa\nbbbbbb"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wrapped := wrap(tt.text, tt.width)
+			test.Assert(t, wrapped == tt.expected, wrapped)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Package clitest provides the CLI shared by the tests of the packages describing CLIs,
+// like the documentation generators and the schema.
+package clitest
+
+import (
+	"context"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+)
+
+// NewCLI creates a CLI named app using most of the features described by documentations and schemas.
+// Its descriptions contain characters to escape in the supported documentation formats.
+//
+// It is made of:
+//   - the root command, with a persistent flag and a persistent hook,
+//   - the serve command, with aliases, examples, arguments, flags and a hook,
+//   - the hidden secret command,
+//   - the deprecated old command, with a sub command.
+func NewCLI() *cli.CLI {
+	var (
+		verbose bool
+		port    int
+		host    string
+		level   string
+		target  string
+		files   []string
+	)
+
+	root := double.NewFake(
+		double.FakeWithDescription(func() string { return "My application\n\nIt serves *things*." }),
+		double.FakeWithPersistentFlags(func() []cli.Flag {
+			return []cli.Flag{cli.NewBuiltinFlag("verbose", "v", &verbose, "Enable verbose logs", cli.WithEnv("APP_VERBOSE"))}
+		}),
+		double.FakeWithPersistentHook(func() *cli.PersistentHook {
+			return &cli.PersistentHook{BeforeCommandExecution: func(context.Context) error { return nil }}
+		}),
+	)
+
+	serve := double.NewFake(
+		double.FakeWithDescription(func() string { return "Serve things\n.ini files are read" }),
+		double.FakeWithUsage(func() string { return "<target> [files...]" }),
+		double.FakeWithAliases(func() []string { return []string{"run"} }),
+		double.FakeWithExamples(func() []string { return []string{"app serve --port 8080 api"} }),
+		double.FakeWithArgs(func() *cli.ArgsSpec {
+			return &cli.ArgsSpec{Positional: &cli.ArgList{
+				Args:        []cli.Arg{cli.NewArg("target", &target, "Target to serve")},
+				Variadic:    cli.NewVariadicArg("files", &files, "Files to serve"),
+				MinVariadic: 1,
+			}}
+		}),
+		double.FakeWithFlags(func() []cli.Flag {
+			port = 8080
+			return []cli.Flag{
+				cli.NewBuiltinFlag("port", "p", &port, "Port to listen on",
+					cli.WithEnv("APP_PORT", "PORT"),
+					cli.WithAliases("listen-port"), cli.WithDeprecatedAliases("listen"),
+					cli.WithValidators(cli.InRange(1, 65535)),
+				),
+				cli.NewBuiltinFlag("host", "", &host, "Host_name to listen on | interface", cli.WithRequired()),
+				cli.NewEnumFlag("log-level", "", &level, []string{"info", "debug"}, "Log level", cli.WithHidden(), cli.WithDeprecated("use --verbose")),
+			}
+		}),
+		double.FakeWithHook(func() *cli.Hook {
+			return &cli.Hook{AfterCommandExecution: func(context.Context) error { return nil }}
+		}),
+	)
+
+	return cli.New(root).
+		AddCommand("serve", serve).
+		AddCommand("secret", double.NewFake(), cli.WithHiddenCommand()).
+		Mount("old", cli.New(double.NewFake()).AddCommand("sub", double.NewFake()), cli.WithDeprecatedCommand("use serve"))
+}
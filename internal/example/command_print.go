@@ -2,7 +2,6 @@ package example
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"strconv"
@@ -25,10 +24,20 @@ type commandPrintConfig struct {
 	C []string
 }
 
-// Usage returns the usage string for the CommandPrint command,
-// indicating the expected positional and dashed arguments.
-func (cmd *CommandPrint) Usage() string {
-	return "pos args -- dashed args"
+// Args returns the arguments accepted by the CommandPrint command: between one and
+// three positional arguments, and any number of dashed arguments.
+// The usage string is generated from it.
+func (cmd *CommandPrint) Args() *cli.ArgsSpec {
+	return &cli.ArgsSpec{
+		Positional: &cli.ArgList{
+			Variadic:    cli.NewArgWithValuer("arg", nil, "argument to print"),
+			MinVariadic: 1,
+			MaxVariadic: 3,
+		},
+		Dashed: &cli.ArgList{
+			Variadic: cli.NewArgWithValuer("dashed-arg", nil, "dashed argument to print"),
+		},
+	}
 }
 
 // Examples returns a slice of example usage strings for the CommandPrint command.
@@ -57,16 +66,9 @@ func (cmd *CommandPrint) Flags() []cli.Flag {
 }
 
 // Execute implements the main logic of the CommandPrint command. It processes
-// positional and dashed arguments, validates the number of arguments, and
+// positional and dashed arguments, already validated against Args, and
 // prints the arguments along with their corresponding flag values (if any).
 func (cmd *CommandPrint) Execute(_ context.Context, args, dashedArgs []string) error {
-	if len(args) == 0 {
-		return cli.NewErrorWithHelp(errors.New("there should be at least 1 arg to print"))
-	}
-	if len(args) > 3 {
-		return errors.New("there should be no more than 3 args to print")
-	}
-
 	for i, arg := range args {
 		var flag string
 		switch arg {
@@ -2,7 +2,6 @@ package example
 
 import (
 	"bytes"
-	"strings"
 	"testing"
 
 	"github.com/krostar/test"
@@ -23,27 +22,9 @@ dashedArgs[1] = barbar
 `)
 	})
 
-	t.Run("ko", func(t *testing.T) {
-		t.Run("bad arguments numbers", func(t *testing.T) {
-			for _, tt := range []struct {
-				args          []string
-				errorContains string
-			}{
-				{
-					args:          nil,
-					errorContains: "there should be at least 1 arg to print",
-				}, {
-					args:          []string{},
-					errorContains: "there should be at least 1 arg to print",
-				}, {
-					args:          []string{"a", "b", "c", "d"},
-					errorContains: "there should be no more than 3 args to print",
-				},
-			} {
-				cmd := new(CommandPrint)
-				err := cmd.Execute(ctx, tt.args, nil)
-				test.Assert(t, err != nil && strings.Contains(err.Error(), tt.errorContains))
-			}
-		})
+	t.Run("args", func(t *testing.T) {
+		spec := new(CommandPrint).Args()
+		test.Require(t, spec != nil)
+		test.Assert(t, spec.Usage() == "<arg>... -- [dashed-arg...]")
 	})
 }
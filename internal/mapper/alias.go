@@ -0,0 +1,185 @@
+package mapper
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/krostar/cli"
+)
+
+// FlagAliases returns the aliases of the provided flag, if any.
+func FlagAliases(flag cli.Flag) []cli.FlagAlias {
+	if get, ok := asFlag[cli.FlagAliases](flag); ok {
+		return get.Aliases()
+	}
+
+	return nil
+}
+
+// FlagsWithAliases returns the provided flags, each one followed by one flag per alias.
+// Alias flags set the value of their flag, but track on their own whether they have been
+// used, to warn about deprecated aliases. They are hidden, and are neither required nor
+// part of groups: those are checked on the flag they alias. Flags having aliases are wrapped
+// to track whether they have been used under their own names, to warn once per use.
+func FlagsWithAliases(flags []cli.Flag) []cli.Flag {
+	withAliases := make([]cli.Flag, 0, len(flags))
+
+	for _, flag := range flags {
+		aliases := FlagAliases(flag)
+		if len(aliases) == 0 {
+			withAliases = append(withAliases, flag)
+			continue
+		}
+
+		withAliases = append(withAliases, &aliasedFlag{Flag: flag})
+		for _, alias := range aliases {
+			withAliases = append(withAliases, &aliasFlag{Flag: flag, alias: alias})
+		}
+	}
+
+	return withAliases
+}
+
+// CheckFlagsNames ensures that the long and short names of the provided flags,
+// aliases included, are all different.
+func CheckFlagsNames(flags []cli.Flag) error {
+	owners := make(map[string]cli.Flag)
+
+	for _, flag := range FlagsWithAliases(flags) {
+		for _, name := range commandLineNames(flag) {
+			if owner, exists := owners[name]; exists {
+				return fmt.Errorf("flag %s redefined: used by %s and %s", name, FlagName(owner), FlagName(flag))
+			}
+
+			owners[name] = flag
+		}
+	}
+
+	return nil
+}
+
+// InheritedFlagsNotShadowed returns the inherited flags that are not shadowed: flags whose names or aliases
+// are used by the provided flags, or by inherited flags of nearer parents, cannot be used by the command.
+func InheritedFlagsNotShadowed(flags, inherited []cli.Flag) []cli.Flag {
+	used := make(map[string]bool)
+	for _, flag := range FlagsWithAliases(flags) {
+		for _, name := range commandLineNames(flag) {
+			used[name] = true
+		}
+	}
+
+	var notShadowed []cli.Flag
+
+	for _, flag := range inherited {
+		names := slices.Concat(commandLineNames(flag), aliasesNames(flag))
+		if slices.ContainsFunc(names, func(name string) bool { return used[name] }) {
+			continue
+		}
+
+		for _, name := range names {
+			used[name] = true
+		}
+
+		notShadowed = append(notShadowed, flag)
+	}
+
+	return notShadowed
+}
+
+// commandLineNames returns the names of the flag as used on the command line, like --port and -p.
+func commandLineNames(flag cli.Flag) []string {
+	var names []string
+
+	if longName := flag.LongName(); longName != "" {
+		names = append(names, "--"+longName)
+	}
+
+	if shortName := flag.ShortName(); shortName != "" {
+		names = append(names, "-"+shortName)
+	}
+
+	return names
+}
+
+// aliasesNames returns the names of the aliases of the flag as used on the command line, like --listen-port.
+func aliasesNames(flag cli.Flag) []string {
+	var names []string
+
+	for _, alias := range FlagAliases(flag) {
+		names = append(names, "--"+alias.Name)
+	}
+
+	return names
+}
+
+// aliasFlag is a flag parsed under the name of an alias of another flag.
+type aliasFlag struct {
+	cli.Flag
+
+	alias cli.FlagAlias
+	used  bool
+}
+
+func (f *aliasFlag) LongName() string      { return f.alias.Name }
+func (*aliasFlag) ShortName() string       { return "" }
+func (*aliasFlag) Hidden() bool            { return true }
+func (f *aliasFlag) IsSet() bool           { return f.used }
+func (f *aliasFlag) Counter() bool         { return FlagCounter(f.Flag) }
+func (f *aliasFlag) Candidates() []string  { return FlagCandidates(f.Flag) }
+func (f *aliasFlag) DefaultString() string { return FlagDefaultString(f.Flag) }
+
+// ResetToDefault resets the flag, and forgets the alias has been used.
+func (f *aliasFlag) ResetToDefault() {
+	ResetFlags([]cli.Flag{f.Flag})
+	f.used = false
+}
+
+func (f *aliasFlag) FromString(raw string) error {
+	if err := f.Flag.FromString(raw); err != nil {
+		return err
+	}
+
+	f.used = true
+
+	return nil
+}
+
+// Deprecation returns the deprecation message of the alias, pointing to the flag name,
+// or the deprecation message of the flag itself.
+func (f *aliasFlag) Deprecation() string {
+	if f.alias.Deprecated {
+		return "use " + FlagName(f.Flag) + " instead"
+	}
+
+	return FlagDeprecation(f.Flag)
+}
+
+// aliasedFlag is a flag having aliases, which tracks whether it has been set under its own names.
+type aliasedFlag struct {
+	cli.Flag
+
+	used bool
+}
+
+func (f *aliasedFlag) Unwrap() cli.Flag { return f.Flag }
+
+// ResetToDefault resets the flag, and forgets it has been used.
+func (f *aliasedFlag) ResetToDefault() {
+	ResetFlags([]cli.Flag{f.Flag})
+	f.used = false
+}
+
+func (f *aliasedFlag) FromString(raw string) error {
+	if err := f.Flag.FromString(raw); err != nil {
+		return err
+	}
+
+	f.used = true
+
+	return nil
+}
+
+// setThroughAliasesOnly returns whether the flag has been set on the command line, but only under the names of its aliases.
+func (f *aliasedFlag) setThroughAliasesOnly() bool {
+	return !f.used && cli.GetFlagSource(f.Flag) == cli.FlagSourceCommandLine
+}
@@ -0,0 +1,128 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+)
+
+func Test_FlagAliases(t *testing.T) {
+	var s string
+
+	test.Assert(t, FlagAliases(cli.NewBuiltinFlag("a", "", &s, "")) == nil)
+
+	aliases := FlagAliases(cli.NewEnumFlag("a", "", &s, []string{"x"}, "", cli.WithAliases("b")))
+	test.Assert(t, len(aliases) == 1 && aliases[0].Name == "b")
+}
+
+func Test_FlagsWithAliases(t *testing.T) {
+	var (
+		level string
+		count int
+	)
+
+	flags := FlagsWithAliases([]cli.Flag{
+		cli.NewEnumFlag("log-level", "l", &level, []string{"debug", "info"}, "",
+			cli.WithRequired(), cli.WithAliases("level"), cli.WithDeprecatedAliases("log-lvl"),
+		),
+		cli.NewCounterFlag("verbose", "v", &count, "", cli.WithDeprecated("use --log-level instead"), cli.WithAliases("verbosity")),
+	})
+	test.Require(t, len(flags) == 5)
+	test.Assert(t, flags[0].LongName() == "log-level" && flags[3].LongName() == "verbose")
+
+	alias, deprecatedAlias, counterAlias := flags[1], flags[2], flags[4]
+
+	t.Run("names", func(t *testing.T) {
+		test.Assert(t, alias.LongName() == "level" && alias.ShortName() == "")
+		test.Assert(t, deprecatedAlias.LongName() == "log-lvl" && deprecatedAlias.ShortName() == "")
+	})
+
+	t.Run("behaviors", func(t *testing.T) {
+		test.Assert(t, FlagHidden(alias) && FlagHidden(deprecatedAlias))
+		test.Assert(t, !FlagRequired(alias) && len(FlagGroups(alias)) == 0)
+		test.Assert(t, len(FlagCandidates(alias)) == 2)
+		test.Assert(t, FlagCounter(counterAlias) && !FlagCounter(alias))
+		test.Assert(t, FlagRequired(flags[0]) && len(FlagCandidates(flags[0])) == 2 && len(FlagAliases(flags[0])) == 2)
+	})
+
+	t.Run("deprecation", func(t *testing.T) {
+		test.Assert(t, FlagDeprecation(alias) == "")
+		test.Assert(t, FlagDeprecation(deprecatedAlias) == "use --log-level instead")
+		test.Assert(t, FlagDeprecation(counterAlias) == "use --log-level instead")
+	})
+
+	t.Run("value is shared", func(t *testing.T) {
+		test.Assert(t, deprecatedAlias.FromString("trace") != nil)
+		test.Assert(t, !deprecatedAlias.IsSet() && !flags[0].IsSet())
+
+		test.Require(t, deprecatedAlias.FromString("info") == nil)
+		test.Assert(t, level == "info" && deprecatedAlias.IsSet() && flags[0].IsSet() && !alias.IsSet())
+		test.Assert(t, alias.String() == "info" && alias.Destination() == &level)
+	})
+}
+
+func Test_CheckFlagsNames(t *testing.T) {
+	var s string
+
+	for name, tc := range map[string]struct {
+		flags       []cli.Flag
+		expectedErr string
+	}{
+		"no collision": {
+			flags: []cli.Flag{
+				cli.NewBuiltinFlag("a", "a", &s, "", cli.WithAliases("aa")),
+				cli.NewBuiltinFlag("b", "b", &s, "", cli.WithUnderscoreAliases()),
+			},
+		},
+		"long names": {
+			flags:       []cli.Flag{cli.NewBuiltinFlag("a", "", &s, ""), cli.NewBuiltinFlag("a", "", &s, "")},
+			expectedErr: "flag --a redefined: used by --a and --a",
+		},
+		"short names": {
+			flags:       []cli.Flag{cli.NewBuiltinFlag("a", "x", &s, ""), cli.NewBuiltinFlag("b", "x", &s, "")},
+			expectedErr: "flag -x redefined: used by --a and --b",
+		},
+		"alias and name": {
+			flags:       []cli.Flag{cli.NewBuiltinFlag("a", "", &s, "", cli.WithDeprecatedAliases("b")), cli.NewBuiltinFlag("b", "", &s, "")},
+			expectedErr: "flag --b redefined: used by --b and --b",
+		},
+		"underscore aliases": {
+			flags:       []cli.Flag{cli.NewBuiltinFlag("a-b", "", &s, "", cli.WithUnderscoreAliases()), cli.NewBuiltinFlag("c", "", &s, "", cli.WithAliases("a_b"))},
+			expectedErr: "flag --a_b redefined: used by --a_b and --a_b",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := CheckFlagsNames(tc.flags)
+			if tc.expectedErr == "" {
+				test.Assert(t, err == nil, "%v", err)
+			} else {
+				test.Assert(t, err != nil && err.Error() == tc.expectedErr, "%v", err)
+			}
+		})
+	}
+}
+
+func Test_InheritedFlagsNotShadowed(t *testing.T) {
+	var s string
+
+	var (
+		local     = cli.NewBuiltinFlag("local", "l", &s, "", cli.WithAliases("loc"))
+		byName    = cli.NewBuiltinFlag("local", "", &s, "")
+		byShort   = cli.NewBuiltinFlag("short", "l", &s, "")
+		byAlias   = cli.NewBuiltinFlag("loc", "", &s, "")
+		aliased   = cli.NewBuiltinFlag("other", "", &s, "", cli.WithAliases("local"))
+		nearest   = cli.NewBuiltinFlag("parent", "p", &s, "")
+		furthest  = cli.NewBuiltinFlag("grandparent", "p", &s, "")
+		unrelated = cli.NewBuiltinFlag("unrelated", "u", &s, "")
+	)
+
+	notShadowed := InheritedFlagsNotShadowed(
+		[]cli.Flag{local},
+		[]cli.Flag{byName, byShort, byAlias, aliased, nearest, furthest, unrelated},
+	)
+	test.Assert(t, len(notShadowed) == 2 && notShadowed[0] == nearest && notShadowed[1] == unrelated)
+
+	test.Assert(t, len(InheritedFlagsNotShadowed(nil, nil)) == 0)
+}
@@ -0,0 +1,117 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/krostar/cli"
+)
+
+// CheckArgs ensures that the positional and dashed lists of the provided specification are consistent.
+// It is called by mappers when commands are built, the arguments being validated against
+// the specification at execution only, see ParseArgs.
+func CheckArgs(spec *cli.ArgsSpec) error {
+	if spec == nil {
+		return nil
+	}
+
+	if err := checkArgList(spec.Positional); err != nil {
+		return fmt.Errorf("invalid positional arguments specification: %w", err)
+	}
+
+	if err := checkArgList(spec.Dashed); err != nil {
+		return fmt.Errorf("invalid dashed arguments specification: %w", err)
+	}
+
+	return nil
+}
+
+// ParseArgs validates the positional and dashed arguments against the provided
+// specification, and parses each of them using the matching argument valuer.
+// The specification is expected to be valid, see CheckArgs.
+// The arguments are reset to their default value beforehand, like flags are, see ResetFlags.
+// Errors caused by the provided arguments are wrapped to request the help to be shown.
+func ParseArgs(spec *cli.ArgsSpec, args, dashedArgs []string) error {
+	if spec == nil {
+		return nil
+	}
+
+	// arguments may be reused across executions, values of a previous execution must not leak
+	for _, arg := range spec.All() {
+		if reset, ok := arg.(cli.FlagDefault); ok && arg.IsSet() {
+			reset.ResetToDefault()
+		}
+	}
+
+	if err := parseArgList(spec.Positional, "positional", args); err != nil {
+		return err
+	}
+
+	return parseArgList(spec.Dashed, "dashed", dashedArgs)
+}
+
+func parseArgList(list *cli.ArgList, kind string, args []string) error {
+	if list == nil {
+		return nil
+	}
+
+	if required := len(list.Args) - list.Optional; len(args) < required {
+		var missing []string
+		for _, arg := range list.Args[len(args):required] {
+			missing = append(missing, strconv.Quote(arg.Name()))
+		}
+
+		noun := "argument"
+		if len(missing) > 1 {
+			noun += "s"
+		}
+
+		return cli.NewErrorWithHelp(fmt.Errorf("missing %s %s %s", kind, noun, strings.Join(missing, ", ")))
+	}
+
+	for i, raw := range args {
+		var arg cli.Arg
+
+		switch variadicIndex := i - len(list.Args); {
+		case variadicIndex < 0:
+			arg = list.Args[i]
+		case list.Variadic != nil && (list.MaxVariadic == 0 || variadicIndex < list.MaxVariadic):
+			arg = list.Variadic
+		default:
+			return cli.NewErrorWithHelp(fmt.Errorf("unexpected %s argument %q", kind, raw))
+		}
+
+		if err := arg.FromString(raw); err != nil {
+			return cli.NewErrorWithHelp(fmt.Errorf("invalid value %q for %s argument %q: %w", raw, kind, arg.Name(), err))
+		}
+	}
+
+	if list.Variadic != nil {
+		if variadicCount := max(len(args)-len(list.Args), 0); variadicCount < list.MinVariadic {
+			return cli.NewErrorWithHelp(fmt.Errorf("missing %s argument %q: expected at least %d, got %d", kind, list.Variadic.Name(), list.MinVariadic, variadicCount))
+		}
+	}
+
+	return nil
+}
+
+func checkArgList(list *cli.ArgList) error {
+	switch {
+	case list == nil:
+		return nil
+	case list.Optional < 0 || list.Optional > len(list.Args):
+		return fmt.Errorf("optional arguments count %d is out of range", list.Optional)
+	case list.MinVariadic < 0 || list.MaxVariadic < 0:
+		return errors.New("variadic arguments bounds cannot be negative")
+	case list.MaxVariadic > 0 && list.MaxVariadic < list.MinVariadic:
+		return errors.New("maximum variadic arguments is lower than minimum")
+	case list.Variadic == nil && (list.MinVariadic > 0 || list.MaxVariadic > 0):
+		return errors.New("variadic arguments bounds are set without variadic argument")
+	case list.Optional > 0 && list.MinVariadic > 0:
+		return errors.New("required variadic arguments cannot follow optional arguments")
+	}
+
+	return nil
+}
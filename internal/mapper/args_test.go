@@ -0,0 +1,184 @@
+package mapper
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+
+	"github.com/krostar/cli"
+)
+
+func Test_ParseArgs(t *testing.T) {
+	t.Run("nil spec", func(t *testing.T) {
+		test.Assert(t, ParseArgs(nil, []string{"a"}, []string{"b"}) == nil)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		var (
+			port     int
+			host     string
+			variadic []string
+			dashed   string
+		)
+
+		spec := &cli.ArgsSpec{
+			Positional: &cli.ArgList{
+				Args: []cli.Arg{
+					cli.NewArgWithValuer("port", cli.NewFlagValuer(&port, strconv.Atoi, strconv.Itoa), ""),
+					cli.NewArgWithValuer("host", cli.NewFlagValuer(&host, func(s string) (string, error) { return s, nil }, func(s string) string { return s }), ""),
+				},
+				Variadic: cli.NewArgWithValuer("more", cli.NewFlagValuer(&variadic,
+					func(s string) ([]string, error) { return append(variadic, s), nil },
+					func(s []string) string { return strings.Join(s, ",") },
+				), ""),
+			},
+			Dashed: &cli.ArgList{Args: []cli.Arg{
+				cli.NewArgWithValuer("cmd", cli.NewFlagValuer(&dashed, func(s string) (string, error) { return s, nil }, func(s string) string { return s }), ""),
+			}},
+		}
+
+		err := ParseArgs(spec, []string{"8080", "localhost", "a", "b"}, []string{"ls"})
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, port == 8080)
+		test.Assert(t, host == "localhost")
+		test.Assert(check.Compare(t, variadic, []string{"a", "b"}))
+		test.Assert(t, dashed == "ls")
+	})
+
+	t.Run("arguments are reset before parsing", func(t *testing.T) {
+		var (
+			name  = "default"
+			files []string
+		)
+
+		spec := &cli.ArgsSpec{Positional: &cli.ArgList{
+			Args:     []cli.Arg{cli.NewArg("name", &name, "")},
+			Optional: 1,
+			Variadic: cli.NewVariadicArg("files", &files, ""),
+		}}
+
+		test.Require(t, ParseArgs(spec, []string{"john", "a", "b"}, nil) == nil)
+		test.Assert(t, name == "john" && slices.Equal(files, []string{"a", "b"}), "%s %v", name, files)
+
+		test.Require(t, ParseArgs(spec, []string{"jane", "c"}, nil) == nil)
+		test.Assert(t, name == "jane" && slices.Equal(files, []string{"c"}), "%s %v", name, files)
+
+		test.Require(t, ParseArgs(spec, nil, nil) == nil)
+		test.Assert(t, name == "default" && files == nil, "%s %v", name, files)
+	})
+
+	t.Run("ko", func(t *testing.T) {
+		var port int
+
+		a, b, c := cli.NewArgWithValuer("a", nil, ""), cli.NewArgWithValuer("b", nil, ""), cli.NewArgWithValuer("c", nil, "")
+		portArg := cli.NewArgWithValuer("port", cli.NewFlagValuer(&port, strconv.Atoi, strconv.Itoa), "")
+
+		for name, tt := range map[string]struct {
+			spec          *cli.ArgsSpec
+			args          []string
+			dashedArgs    []string
+			expectedError string
+			expectHelp    bool
+		}{
+			"missing argument": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{a, b, c}, Optional: 1}},
+				args:          []string{"1"},
+				expectedError: `missing positional argument "b"`,
+				expectHelp:    true,
+			},
+			"missing arguments": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{a, b}}},
+				expectedError: `missing positional arguments "a", "b"`,
+				expectHelp:    true,
+			},
+			"missing variadic arguments": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{a}, Variadic: b, MinVariadic: 2}},
+				args:          []string{"1", "2"},
+				expectedError: `missing positional argument "b": expected at least 2, got 1`,
+				expectHelp:    true,
+			},
+			"unexpected argument": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{a}}},
+				args:          []string{"1", "2"},
+				expectedError: `unexpected positional argument "2"`,
+				expectHelp:    true,
+			},
+			"too many variadic arguments": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{Variadic: a, MaxVariadic: 2}},
+				args:          []string{"1", "2", "3"},
+				expectedError: `unexpected positional argument "3"`,
+				expectHelp:    true,
+			},
+			"unexpected dashed argument": {
+				spec:          &cli.ArgsSpec{Dashed: &cli.ArgList{}},
+				args:          []string{"1"},
+				dashedArgs:    []string{"2"},
+				expectedError: `unexpected dashed argument "2"`,
+				expectHelp:    true,
+			},
+			"invalid value": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{portArg}}},
+				args:          []string{"abc"},
+				expectedError: `invalid value "abc" for positional argument "port": strconv.Atoi: parsing "abc": invalid syntax`,
+				expectHelp:    true,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				err := ParseArgs(tt.spec, tt.args, tt.dashedArgs)
+				test.Require(t, err != nil)
+				test.Assert(t, err.Error() == tt.expectedError, "got %q", err.Error())
+
+				var showHelpErr cli.ShowHelpError
+				test.Assert(t, errors.As(err, &showHelpErr) == tt.expectHelp)
+			})
+		}
+	})
+}
+
+func Test_CheckArgs(t *testing.T) {
+	a, b := cli.NewArgWithValuer("a", nil, ""), cli.NewArgWithValuer("b", nil, "")
+
+	t.Run("ok", func(t *testing.T) {
+		test.Assert(t, CheckArgs(nil) == nil)
+		test.Assert(t, CheckArgs(&cli.ArgsSpec{}) == nil)
+		test.Assert(t, CheckArgs(&cli.ArgsSpec{
+			Positional: &cli.ArgList{Args: []cli.Arg{a}, Optional: 1, Variadic: b, MaxVariadic: 2},
+			Dashed:     &cli.ArgList{Variadic: b, MinVariadic: 1},
+		}) == nil)
+	})
+
+	t.Run("ko", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			spec          *cli.ArgsSpec
+			expectedError string
+		}{
+			"invalid optional count": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{a}, Optional: 2}},
+				expectedError: "invalid positional arguments specification: optional arguments count 2 is out of range",
+			},
+			"invalid variadic bounds": {
+				spec:          &cli.ArgsSpec{Dashed: &cli.ArgList{Variadic: a, MinVariadic: 3, MaxVariadic: 2}},
+				expectedError: "invalid dashed arguments specification: maximum variadic arguments is lower than minimum",
+			},
+			"variadic bounds without variadic": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{MinVariadic: 1}},
+				expectedError: "invalid positional arguments specification: variadic arguments bounds are set without variadic argument",
+			},
+			"required variadic after optional": {
+				spec:          &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{a}, Optional: 1, Variadic: b, MinVariadic: 1}},
+				expectedError: "invalid positional arguments specification: required variadic arguments cannot follow optional arguments",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				err := CheckArgs(tt.spec)
+				test.Require(t, err != nil)
+				test.Assert(t, err.Error() == tt.expectedError, "got %q", err.Error())
+			})
+		}
+	})
+}
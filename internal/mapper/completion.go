@@ -0,0 +1,275 @@
+package mapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/krostar/cli"
+	clicompletion "github.com/krostar/cli/completion"
+)
+
+// CompletionRequested returns whether the provided arguments, without the program name,
+// are a completion request made by the scripts of the clicompletion package.
+func CompletionRequested(args []string) bool {
+	return len(args) > 0 && args[0] == clicompletion.RequestCommandName
+}
+
+// WriteCompletion answers a completion request: the provided arguments are the ones following
+// clicompletion.RequestCommandName, the last one being the argument to complete. The candidates
+// are written along with the directive even if an error occurred, for the shell to ignore them.
+func WriteCompletion(ctx context.Context, w io.Writer, c *cli.CLI, args []string) error {
+	candidates, directive, err := Complete(ctx, c, args)
+	return errors.Join(err, clicompletion.WriteCandidates(w, candidates, directive))
+}
+
+// Complete returns the completion candidates of the last of the provided arguments, given
+// the arguments preceding it, without the program name. The command is found by following the
+// subcommands names, each command being entered like WalkCommands does, see WalkCommands.
+// The arguments are not parsed: flags values are skipped, without being set.
+func Complete(ctx context.Context, c *cli.CLI, args []string) ([]string, clicompletion.Directive, error) {
+	var toComplete string
+	if len(args) > 0 {
+		args, toComplete = args[:len(args)-1], args[len(args)-1]
+	}
+
+	var (
+		node  *CommandNode
+		flags []cli.Flag
+	)
+
+	enter := func(sub *cli.CLI) error {
+		var err error
+
+		ctx, node, err = enterCommand(ctx, sub, node)
+		if err != nil {
+			return err
+		}
+
+		flags = FlagsWithAliases(slices.Concat(node.Flags, node.PersistentFlags, node.InheritedFlags))
+		c = sub
+
+		return nil
+	}
+
+	if err := enter(c); err != nil {
+		return nil, clicompletion.DirectiveError, err
+	}
+
+	var (
+		positionalArgs []string
+		dashed         bool
+		valueOf        cli.Flag
+	)
+
+	for _, arg := range args {
+		switch {
+		case valueOf != nil:
+			valueOf = nil
+		case dashed:
+			positionalArgs = append(positionalArgs, arg)
+		case arg == "--":
+			dashed = true
+		case isFlagArg(arg):
+			valueOf = flagExpectingValueInNextArg(flags, arg)
+		default:
+			if sub := subCommand(c, arg); sub != nil && len(positionalArgs) == 0 {
+				if err := enter(sub); err != nil {
+					return nil, clicompletion.DirectiveError, err
+				}
+
+				continue
+			}
+
+			positionalArgs = append(positionalArgs, arg)
+		}
+	}
+
+	switch {
+	case valueOf != nil:
+		return CompleteFlag(ctx, valueOf, toComplete)
+	case !dashed && strings.HasPrefix(toComplete, "-"):
+		if name, value, hasValue := strings.Cut(toComplete, "="); hasValue && strings.HasPrefix(name, "--") {
+			if flag := lookupFlag(flags, strings.TrimPrefix(name, "--"), ""); flag != nil {
+				return CompleteFlag(ctx, flag, value)
+			}
+
+			return nil, clicompletion.DirectiveNoFileCompletion, nil
+		}
+
+		return flagsNamesCandidates(flags, toComplete), clicompletion.DirectiveNoFileCompletion, nil
+	default:
+		candidates, directive, err := CompleteArgs(ctx, c.Command, positionalArgs, toComplete)
+		if !dashed && len(positionalArgs) == 0 {
+			candidates = append(subCommandsCandidates(c, toComplete), candidates...)
+		}
+
+		return candidates, directive, err
+	}
+}
+
+// CompleteArgs returns the completion candidates of the argument of the provided command starting with toComplete,
+// given the arguments already provided, and the directive telling the shell how to handle them.
+// Commands not implementing cli.CommandCompletion let the shell complete files.
+func CompleteArgs(ctx context.Context, cmd cli.Command, args []string, toComplete string) ([]string, clicompletion.Directive, error) {
+	get, ok := cli.AsCommand[cli.CommandCompletion](cmd)
+	if !ok {
+		return nil, clicompletion.DirectiveDefault, nil
+	}
+
+	candidates, err := get.Complete(ctx, args, toComplete)
+	if err != nil {
+		return nil, clicompletion.DirectiveError, fmt.Errorf("unable to complete arguments: %w", err)
+	}
+
+	return filterCandidates(candidates, toComplete), clicompletion.DirectiveNoFileCompletion, nil
+}
+
+// FlagCompletesValues returns whether the values of the provided flag are completed by CompleteFlag, instead of files.
+func FlagCompletesValues(flag cli.Flag) bool {
+	if alias, isAlias := flag.(*aliasFlag); isAlias {
+		flag = alias.Flag
+	}
+
+	if get, ok := asFlag[cli.FlagCompletion](flag); ok && get.CompletesValues() {
+		return true
+	}
+
+	return len(FlagCandidates(flag)) > 0
+}
+
+// CompleteFlag returns the completion candidates of the value of the provided flag starting with toComplete,
+// and the directive telling the shell how to handle them. Flags completing their values (see cli.FlagCompletion)
+// are asked for candidates, then the candidates of the flag (see cli.FlagCandidates) are used, and the shell
+// completes files otherwise.
+func CompleteFlag(ctx context.Context, flag cli.Flag, toComplete string) ([]string, clicompletion.Directive, error) {
+	// aliases do not forward the optional interfaces of their flag
+	if alias, isAlias := flag.(*aliasFlag); isAlias {
+		flag = alias.Flag
+	}
+
+	if get, ok := asFlag[cli.FlagCompletion](flag); ok && get.CompletesValues() {
+		candidates, err := get.Complete(ctx, toComplete)
+		if err != nil {
+			return nil, clicompletion.DirectiveError, fmt.Errorf("unable to complete flag %s: %w", FlagName(flag), err)
+		}
+
+		return filterCandidates(candidates, toComplete), clicompletion.DirectiveNoFileCompletion, nil
+	}
+
+	if candidates := FlagCandidates(flag); len(candidates) > 0 {
+		return filterCandidates(candidates, toComplete), clicompletion.DirectiveNoFileCompletion, nil
+	}
+
+	return nil, clicompletion.DirectiveDefault, nil
+}
+
+// filterCandidates returns the candidates starting with toComplete.
+func filterCandidates(candidates []string, toComplete string) []string {
+	return slices.DeleteFunc(slices.Clone(candidates), func(candidate string) bool {
+		return !strings.HasPrefix(candidate, toComplete)
+	})
+}
+
+// subCommandsCandidates returns the names of the visible subcommands of the provided command starting with toComplete.
+func subCommandsCandidates(c *cli.CLI, toComplete string) []string {
+	var candidates []string
+
+	for _, sub := range c.SubCommands {
+		if !CommandHidden(sub.Command) && strings.HasPrefix(sub.Name, toComplete) {
+			candidates = append(candidates, sub.Name)
+		}
+	}
+
+	return candidates
+}
+
+// flagsNamesCandidates returns the long and short names of the visible flags starting with toComplete.
+func flagsNamesCandidates(flags []cli.Flag, toComplete string) []string {
+	var candidates []string
+
+	for _, flag := range flags {
+		if FlagHidden(flag) {
+			continue
+		}
+
+		var names []string
+
+		if longName := flag.LongName(); longName != "" {
+			names = append(names, "--"+longName)
+		}
+
+		if shortName := flag.ShortName(); shortName != "" {
+			names = append(names, "-"+shortName)
+		}
+
+		for _, name := range names {
+			if strings.HasPrefix(name, toComplete) && !slices.Contains(candidates, name) {
+				candidates = append(candidates, name)
+			}
+		}
+	}
+
+	return candidates
+}
+
+// subCommand returns the direct subcommand of the provided command with the provided name or alias, if any.
+func subCommand(c *cli.CLI, name string) *cli.CLI {
+	for _, sub := range c.SubCommands {
+		if sub.Name == name || slices.Contains(Aliases(sub.Command), name) {
+			return sub
+		}
+	}
+
+	return nil
+}
+
+// isFlagArg returns whether the provided argument is a flag, like -f or --flag.
+func isFlagArg(arg string) bool {
+	return len(arg) > 1 && arg[0] == '-'
+}
+
+// lookupFlag returns the first of the provided flags with the provided long or short name, if any.
+func lookupFlag(flags []cli.Flag, longName, shortName string) cli.Flag {
+	for _, flag := range flags {
+		if (longName != "" && flag.LongName() == longName) || (shortName != "" && flag.ShortName() == shortName) {
+			return flag
+		}
+	}
+
+	return nil
+}
+
+// flagExpectingValueInNextArg returns the flag consuming the argument following the provided flag argument
+// as its value, if any: the long or the last short flag of the argument, if it expects a value that is not
+// provided in the argument itself.
+func flagExpectingValueInNextArg(flags []cli.Flag, arg string) cli.Flag {
+	if name, isLong := strings.CutPrefix(arg, "--"); isLong {
+		if flag := lookupFlag(flags, name, ""); flag != nil && FlagExpectsValue(flag) {
+			return flag
+		}
+
+		return nil
+	}
+
+	for i, r := range arg[1:] {
+		flag := lookupFlag(flags, "", string(r))
+		if flag == nil {
+			return nil
+		}
+
+		if FlagExpectsValue(flag) {
+			if i+utf8.RuneLen(r) == len(arg[1:]) {
+				return flag
+			}
+
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package mapper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+	clicompletion "github.com/krostar/cli/completion"
+	"github.com/krostar/cli/double"
+)
+
+func Test_CompletionRequested(t *testing.T) {
+	test.Assert(t, CompletionRequested([]string{clicompletion.RequestCommandName, "serve", ""}))
+	test.Assert(t, !CompletionRequested([]string{"serve", clicompletion.RequestCommandName}))
+	test.Assert(t, !CompletionRequested(nil))
+}
+
+func Test_Complete(t *testing.T) {
+	var (
+		port, count     int
+		cluster, format string
+		verbose         bool
+		completedArgs   []string
+	)
+
+	newCLI := func() *cli.CLI {
+		root := double.NewFake(
+			double.FakeWithPersistentFlags(func() []cli.Flag {
+				return []cli.Flag{cli.NewBuiltinFlag("verbose", "v", &verbose, "")}
+			}),
+		)
+
+		serve := double.NewFake(
+			double.FakeWithFlags(func() []cli.Flag {
+				return []cli.Flag{
+					cli.NewBuiltinFlag("port", "p", &port, "", cli.WithAliases("listen-port")),
+					cli.NewCounterFlag("count", "c", &count, ""),
+					cli.NewBuiltinFlag("cluster", "", &cluster, "", cli.WithCompletion(func(_ context.Context, toComplete string) ([]string, error) {
+						if toComplete == "fail" {
+							return nil, errors.New("boom")
+						}
+						return []string{"prod", "preprod", "staging"}, nil
+					})),
+					cli.NewEnumFlag("format", "f", &format, []string{"json", "text"}, ""),
+					cli.NewBuiltinFlag("secret", "", &cluster, "", cli.WithHidden()),
+				}
+			}),
+			double.FakeWithComplete(func(_ context.Context, args []string, toComplete string) ([]string, error) {
+				if toComplete == "fail" {
+					return nil, errors.New("boom")
+				}
+				completedArgs = args
+				return []string{"alpha", "beta"}, nil
+			}),
+		)
+
+		return cli.New(root).
+			AddCommand("serve", serve).
+			AddCommand("secret", double.NewFake(), cli.WithHiddenCommand()).
+			AddCommand("status", double.NewFake())
+	}
+
+	for name, tc := range map[string]struct {
+		args               []string
+		expectedCandidates []string
+		expectedDirective  clicompletion.Directive
+		expectedArgs       []string
+		expectedErr        string
+	}{
+		"subcommands": {
+			args:               []string{"s"},
+			expectedCandidates: []string{"serve", "status"},
+			expectedDirective:  clicompletion.DirectiveDefault,
+		},
+		"subcommands and args": {
+			args:               []string{"serve", ""},
+			expectedCandidates: []string{"alpha", "beta"},
+			expectedDirective:  clicompletion.DirectiveNoFileCompletion,
+		},
+		"args filtered": {
+			args:               []string{"serve", "--port", "8080", "-v", "foo", "b"},
+			expectedCandidates: []string{"beta"},
+			expectedDirective:  clicompletion.DirectiveNoFileCompletion,
+			expectedArgs:       []string{"foo"},
+		},
+		"args after dash": {
+			args:               []string{"serve", "--", "-p", ""},
+			expectedCandidates: []string{"alpha", "beta"},
+			expectedDirective:  clicompletion.DirectiveNoFileCompletion,
+			expectedArgs:       []string{"-p"},
+		},
+		"args failure": {
+			args:              []string{"serve", "fail"},
+			expectedDirective: clicompletion.DirectiveError,
+			expectedErr:       "unable to complete arguments: boom",
+		},
+		"flags names": {
+			args:               []string{"serve", "--c"},
+			expectedCandidates: []string{"--count", "--cluster"},
+			expectedDirective:  clicompletion.DirectiveNoFileCompletion,
+		},
+		"flags names including inherited but not aliases": {
+			args:               []string{"serve", "-"},
+			expectedCandidates: []string{"--port", "-p", "--count", "-c", "--cluster", "--format", "-f", "--verbose", "-v"},
+			expectedDirective:  clicompletion.DirectiveNoFileCompletion,
+		},
+		"flag values completed": {
+			args:               []string{"serve", "--cluster", "pr"},
+			expectedCandidates: []string{"prod", "preprod"},
+			expectedDirective:  clicompletion.DirectiveNoFileCompletion,
+		},
+		"flag values completed with equal": {
+			args:               []string{"serve", "--cluster=s"},
+			expectedCandidates: []string{"staging"},
+			expectedDirective:  clicompletion.DirectiveNoFileCompletion,
+		},
+		"flag values failure": {
+			args:              []string{"serve", "--cluster", "fail"},
+			expectedDirective: clicompletion.DirectiveError,
+			expectedErr:       "unable to complete flag --cluster: boom",
+		},
+		"flag candidates of grouped short flags": {
+			args:               []string{"serve", "-cf", ""},
+			expectedCandidates: []string{"json", "text"},
+			expectedDirective:  clicompletion.DirectiveNoFileCompletion,
+		},
+		"flag values of aliases": {
+			args:              []string{"serve", "--listen-port", ""},
+			expectedDirective: clicompletion.DirectiveDefault,
+		},
+		"unknown flag with equal": {
+			args:              []string{"serve", "--unknown="},
+			expectedDirective: clicompletion.DirectiveNoFileCompletion,
+		},
+		"command without completion": {
+			args:              []string{"status", ""},
+			expectedDirective: clicompletion.DirectiveDefault,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			completedArgs = nil
+
+			candidates, directive, err := Complete(t.Context(), newCLI(), tc.args)
+			if tc.expectedErr != "" {
+				test.Assert(t, err != nil && err.Error() == tc.expectedErr, err)
+			} else {
+				test.Assert(t, err == nil, err)
+			}
+
+			test.Assert(t, slices.Equal(candidates, tc.expectedCandidates), candidates)
+			test.Assert(t, directive == tc.expectedDirective, directive)
+			test.Assert(t, slices.Equal(completedArgs, tc.expectedArgs), completedArgs)
+		})
+	}
+
+	t.Run("hook failure", func(t *testing.T) {
+		c := cli.New(double.NewFake()).AddCommand("serve", double.NewFake(
+			double.FakeWithPersistentHook(func() *cli.PersistentHook {
+				return &cli.PersistentHook{BeforeFlagsDefinition: func(context.Context) error { return errors.New("boom") }}
+			}),
+		))
+
+		_, directive, err := Complete(t.Context(), c, []string{"serve", ""})
+		test.Assert(t, err != nil && err.Error() == "pre-flag-definition hook of command serve failed: boom", err)
+		test.Assert(t, directive == clicompletion.DirectiveError)
+	})
+}
+
+func Test_WriteCompletion(t *testing.T) {
+	c := cli.New(double.NewFake()).AddCommand("serve", double.NewFake()).AddCommand("status", double.NewFake())
+
+	var buf bytes.Buffer
+
+	test.Require(t, WriteCompletion(t.Context(), &buf, c, []string{"se"}) == nil)
+	test.Assert(t, buf.String() == "serve\n:0\n", buf.String())
+}
+
+func Test_FlagCompletesValues(t *testing.T) {
+	var s string
+
+	test.Assert(t, !FlagCompletesValues(cli.NewBuiltinFlag("a", "", &s, "")))
+	test.Assert(t, FlagCompletesValues(cli.NewBuiltinFlag("a", "", &s, "", cli.WithCompletionValues("x"))))
+	test.Assert(t, FlagCompletesValues(cli.NewEnumFlag("a", "", &s, []string{"x"}, "")))
+	test.Assert(t, FlagCompletesValues(FlagsWithAliases([]cli.Flag{cli.NewEnumFlag("a", "", &s, []string{"x"}, "", cli.WithAliases("b"))})[1]))
+}
@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/krostar/cli"
+)
+
+// CommandHidden returns whether the provided command must not be listed in the help
+// of its parent, which is the case of hidden and deprecated commands.
+func CommandHidden(cmd cli.Command) bool {
+	if get, ok := cli.AsCommand[cli.CommandHidden](cmd); ok && get.Hidden() {
+		return true
+	}
+
+	return CommandDeprecation(cmd) != ""
+}
+
+// CommandDeprecation returns the deprecation message of the provided command, if any.
+func CommandDeprecation(cmd cli.Command) string {
+	if get, ok := cli.AsCommand[cli.CommandDeprecated](cmd); ok {
+		return get.Deprecation()
+	}
+
+	return ""
+}
+
+// NewDeprecationWarnings returns a function that writes deprecation warnings to the
+// exit logger (see cli.Exit): one if the provided command is deprecated, and one for
+// each of the provided deprecated flags that has been set. Flags set through their aliases
+// only are warned about once, under the name of the alias used, see FlagsWithAliases.
+// Warnings have the same format whatever the mapper, like `Flag "--user" is deprecated, use --name instead`.
+func NewDeprecationWarnings(c *cli.CLI, flags []cli.Flag) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		var warnings strings.Builder
+
+		if deprecation := CommandDeprecation(c.Command); deprecation != "" {
+			writeDeprecationWarning(&warnings, "Command", c.Name, deprecation)
+		}
+
+		for _, flag := range flags {
+			if aliased, ok := flag.(*aliasedFlag); ok && aliased.setThroughAliasesOnly() {
+				continue
+			}
+
+			if deprecation := FlagDeprecation(flag); deprecation != "" && flag.IsSet() {
+				writeDeprecationWarning(&warnings, "Flag", FlagName(flag), deprecation)
+			}
+		}
+
+		if warnings.Len() > 0 {
+			_, _ = io.WriteString(cli.GetExitLoggerFromMetadata(ctx), warnings.String())
+		}
+	}
+}
+
+func writeDeprecationWarning(w io.Writer, kind, name, deprecation string) {
+	_, _ = fmt.Fprintf(w, "%s %q is deprecated, %s\n", kind, name, deprecation)
+}
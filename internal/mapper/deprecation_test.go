@@ -0,0 +1,93 @@
+package mapper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+)
+
+func Test_CommandHidden(t *testing.T) {
+	test.Assert(t, !CommandHidden(double.NewFake()))
+	test.Assert(t, !CommandHidden(double.NewFake(double.FakeWithHidden(func() bool { return false }))))
+	test.Assert(t, CommandHidden(double.NewFake(double.FakeWithHidden(func() bool { return true }))))
+	test.Assert(t, CommandHidden(double.NewFake(double.FakeWithDeprecation(func() string { return "use new instead" }))))
+}
+
+func Test_CommandDeprecation(t *testing.T) {
+	test.Assert(t, CommandDeprecation(double.NewFake()) == "")
+	test.Assert(t, CommandDeprecation(double.NewFake(double.FakeWithDeprecation(func() string { return "use new instead" }))) == "use new instead")
+}
+
+func Test_NewDeprecationWarnings(t *testing.T) {
+	var a, b, c string
+
+	flags := []cli.Flag{
+		cli.NewBuiltinFlag("a", "", &a, "", cli.WithDeprecated("use --c instead")),
+		cli.NewBuiltinFlag("", "b", &b, "", cli.WithDeprecated("use --c instead")),
+		cli.NewBuiltinFlag("c", "", &c, ""),
+	}
+
+	for _, flag := range flags {
+		test.Require(t, flag.FromString("value") == nil)
+	}
+
+	t.Run("deprecated command and flags", func(t *testing.T) {
+		output := new(bufferThatCloses)
+		ctx := cli.NewContextWithMetadata(test.Context(t))
+		cli.SetExitLoggerInMetadata(ctx, output)
+
+		NewDeprecationWarnings(cli.New(double.NewFake()).AddCommand("old", double.NewFake(), cli.WithDeprecatedCommand(`use "new" instead`)).SubCommands[0], flags)(ctx)
+		test.Assert(t, output.String() == `Command "old" is deprecated, use "new" instead
+Flag "--a" is deprecated, use --c instead
+Flag "-b" is deprecated, use --c instead
+`, output.String())
+	})
+
+	t.Run("deprecated flags set through their aliases", func(t *testing.T) {
+		var d string
+
+		withAliases := FlagsWithAliases([]cli.Flag{
+			cli.NewBuiltinFlag("d", "", &d, "", cli.WithDeprecated("use --c instead"), cli.WithAliases("e"), cli.WithDeprecatedAliases("f")),
+		})
+		test.Require(t, len(withAliases) == 3)
+
+		warnings := func() string {
+			output := new(bufferThatCloses)
+			ctx := cli.NewContextWithMetadata(test.Context(t))
+			cli.SetExitLoggerInMetadata(ctx, output)
+
+			NewDeprecationWarnings(&cli.CLI{Name: "new", Command: double.NewFake()}, withAliases)(ctx)
+
+			return output.String()
+		}
+
+		test.Require(t, withAliases[2].FromString("value") == nil)
+		test.Assert(t, warnings() == "Flag \"--f\" is deprecated, use --d instead\n", warnings())
+
+		test.Require(t, withAliases[1].FromString("value") == nil)
+		test.Assert(t, warnings() == "Flag \"--e\" is deprecated, use --c instead\nFlag \"--f\" is deprecated, use --d instead\n", warnings())
+
+		test.Require(t, withAliases[0].FromString("value") == nil)
+		test.Assert(t, warnings() == "Flag \"--d\" is deprecated, use --c instead\nFlag \"--e\" is deprecated, use --c instead\nFlag \"--f\" is deprecated, use --d instead\n", warnings())
+
+		ResetFlags(withAliases)
+		test.Assert(t, warnings() == "", warnings())
+	})
+
+	t.Run("nothing deprecated is used", func(t *testing.T) {
+		output := new(bufferThatCloses)
+		ctx := cli.NewContextWithMetadata(test.Context(t))
+		cli.SetExitLoggerInMetadata(ctx, output)
+
+		NewDeprecationWarnings(&cli.CLI{Name: "new", Command: double.NewFake()}, flags[2:])(ctx)
+		test.Assert(t, output.Len() == 0, output.String())
+	})
+}
+
+type bufferThatCloses struct{ bytes.Buffer }
+
+func (*bufferThatCloses) Close() error { return nil }
@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"errors"
+	"os"
+
+	"github.com/krostar/cli"
+)
+
+// FlagEnvNames returns the names of the environment variables bound to the provided flag, if any.
+func FlagEnvNames(flag cli.Flag) []string {
+	if get, ok := asFlag[cli.FlagEnv](flag); ok {
+		return get.EnvNames()
+	}
+
+	return nil
+}
+
+// NewFlagsFromEnv returns a function setting the provided flags that are not set, from
+// the first of their environment variables being set. Mappers call it once the persistent
+// hooks ran, right before the command hook, so that the command hook and the configuration
+// sources it applies see the values from the environment like values provided on the command line.
+// All the invalid values are reported at once.
+func NewFlagsFromEnv(flags []cli.Flag) func() error {
+	type boundFlag struct {
+		cli.Flag
+		env cli.FlagEnv
+	}
+
+	var bound []boundFlag
+
+	for _, flag := range flags {
+		if env, ok := asFlag[cli.FlagEnv](flag); ok && len(env.EnvNames()) > 0 {
+			bound = append(bound, boundFlag{Flag: flag, env: env})
+		}
+	}
+
+	return func() error {
+		var errs []error
+
+		for _, flag := range bound {
+			if flag.IsSet() {
+				continue
+			}
+
+			for _, name := range flag.env.EnvNames() {
+				if value, isSet := os.LookupEnv(name); isSet {
+					errs = append(errs, flag.env.FromEnv(name, value))
+					break
+				}
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,60 @@
+package mapper
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+)
+
+func Test_FlagEnvNames(t *testing.T) {
+	var s string
+
+	test.Assert(t, FlagEnvNames(cli.NewBuiltinFlag("a", "", &s, "")) == nil)
+	test.Assert(t, slices.Equal(FlagEnvNames(cli.NewEnumFlag("a", "", &s, []string{"x"}, "", cli.WithEnv("A", "B"))), []string{"A", "B"}))
+}
+
+func Test_NewFlagsFromEnv(t *testing.T) {
+	t.Setenv("APP_NAME", "john")
+	t.Setenv("NAME", "jane")
+	t.Setenv("APP_PORT", "invalid")
+	t.Setenv("APP_LEVEL", "debug")
+	t.Setenv("APP_VERBOSE", "3")
+
+	var (
+		name, level string
+		port, count int
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		flags := FlagsWithAliases([]cli.Flag{
+			cli.NewBuiltinFlag("name", "", &name, "", cli.WithEnv("UNSET_NAME", "APP_NAME", "NAME"), cli.WithAliases("username")),
+			cli.NewEnumFlag("level", "", &level, []string{"info", "debug"}, "", cli.WithEnv("APP_LEVEL")),
+			cli.NewCounterFlag("verbose", "v", &count, "", cli.WithEnv("APP_VERBOSE")),
+			cli.NewBuiltinFlag("port", "", &port, ""),
+		})
+		ResetFlags(flags)
+		test.Require(t, flags[2].FromString("info") == nil)
+
+		test.Require(t, NewFlagsFromEnv(flags)() == nil)
+		test.Assert(t, name == "john" && cli.GetFlagSource(flags[0]) == "$APP_NAME" && !flags[1].IsSet())
+		test.Assert(t, level == "info" && cli.GetFlagSource(flags[2]) == cli.FlagSourceCommandLine)
+		test.Assert(t, count == 3 && cli.GetFlagSource(flags[3]) == "$APP_VERBOSE")
+		test.Assert(t, port == 0 && !flags[4].IsSet())
+	})
+
+	t.Run("ko", func(t *testing.T) {
+		flags := []cli.Flag{
+			cli.NewBuiltinFlag("port", "", &port, "", cli.WithEnv("APP_PORT")),
+			cli.NewEnumFlag("level", "", &level, []string{"info"}, "", cli.WithEnv("APP_LEVEL")),
+		}
+
+		err := NewFlagsFromEnv(flags)()
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == `environment variable APP_PORT of flag --port: strconv.ParseInt: parsing "invalid": invalid syntax
+environment variable APP_LEVEL of flag --level: invalid value "debug": must be one of info`, err.Error())
+		test.Assert(t, !flags[0].IsSet() && !flags[1].IsSet())
+	})
+}
@@ -0,0 +1,345 @@
+package mapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/krostar/cli"
+)
+
+// asFlag returns the first flag of the chain of wrapped flags implementing T, if any.
+func asFlag[T any](flag cli.Flag) (T, bool) {
+	for flag != nil {
+		if t, ok := flag.(T); ok {
+			return t, true
+		}
+
+		wrapper, ok := flag.(cli.FlagWrapper)
+		if !ok {
+			break
+		}
+
+		flag = wrapper.Unwrap()
+	}
+
+	var zero T
+
+	return zero, false
+}
+
+// FlagRequired returns whether the provided flag is required.
+func FlagRequired(flag cli.Flag) bool {
+	if get, ok := asFlag[cli.FlagRequired](flag); ok {
+		return get.Required()
+	}
+
+	return false
+}
+
+// FlagCandidates returns the values the provided flag is restricted to, if any.
+func FlagCandidates(flag cli.Flag) []string {
+	if get, ok := asFlag[cli.FlagCandidates](flag); ok {
+		return get.Candidates()
+	}
+
+	return nil
+}
+
+// FlagCounter returns whether the provided flag counts the number of times it is provided.
+func FlagCounter(flag cli.Flag) bool {
+	if get, ok := asFlag[cli.FlagCounter](flag); ok {
+		return get.Counter()
+	}
+
+	return false
+}
+
+// FlagExpectsValue returns whether the provided flag requires a value; boolean and counter flags don't.
+func FlagExpectsValue(flag cli.Flag) bool {
+	_, isBool := flag.Destination().(*bool)
+	return !isBool && !FlagCounter(flag)
+}
+
+// FlagHidden returns whether the provided flag must not be listed in help,
+// which is the case of hidden and deprecated flags.
+func FlagHidden(flag cli.Flag) bool {
+	if get, ok := asFlag[cli.FlagHidden](flag); ok && get.Hidden() {
+		return true
+	}
+
+	return FlagDeprecation(flag) != ""
+}
+
+// FlagHelpGroup returns the group of the provided flag in help, if any.
+func FlagHelpGroup(flag cli.Flag) string {
+	if get, ok := asFlag[cli.FlagHelpGroup](flag); ok {
+		return get.HelpGroup()
+	}
+
+	return ""
+}
+
+// FlagDeprecation returns the deprecation message of the provided flag, if any.
+func FlagDeprecation(flag cli.Flag) string {
+	if get, ok := asFlag[cli.FlagDeprecated](flag); ok {
+		return get.Deprecation()
+	}
+
+	return ""
+}
+
+// FlagDefaultString returns the representation of the default value of the provided flag,
+// or of its current value if its default value is unknown.
+func FlagDefaultString(flag cli.Flag) string {
+	if get, ok := asFlag[cli.FlagDefault](flag); ok {
+		return get.DefaultString()
+	}
+
+	return flag.String()
+}
+
+// FlagDocumentedDefault returns the representation of the default value of the provided flag
+// to display in help and documentation, or an empty string if it is not worth displaying:
+// required flags and zero values, like "false" or "0", have no displayed default value.
+func FlagDocumentedDefault(flag cli.Flag) string {
+	if FlagRequired(flag) {
+		return ""
+	}
+
+	switch defaultValue := FlagDefaultString(flag); defaultValue {
+	case "", "false", "0", "[]", "<nil>":
+		return ""
+	default:
+		return defaultValue
+	}
+}
+
+// ResetFlags resets the provided flags that are set to their default value, if they know it. Mappers
+// reset flags before parsing, so that executing the same commands multiple times does not
+// keep the values provided to the previous executions. Flags that are not set are left untouched,
+// keeping the values assigned by the program to their destination after they were created.
+func ResetFlags(flags []cli.Flag) {
+	for _, flag := range flags {
+		if !flag.IsSet() {
+			continue
+		}
+
+		if reset, ok := asFlag[cli.FlagDefault](flag); ok {
+			reset.ResetToDefault()
+		}
+	}
+}
+
+// FlagValidators returns the validators of the provided flag, if any.
+func FlagValidators(flag cli.Flag) []cli.Validator {
+	if get, ok := asFlag[cli.FlagValidators](flag); ok {
+		return get.Validators()
+	}
+
+	return nil
+}
+
+// FlagConstraints returns the description of the constraints checked by the validators of the provided flag.
+func FlagConstraints(flag cli.Flag) []string {
+	var constraints []string
+
+	for _, validator := range FlagValidators(flag) {
+		if constraint := validator.Constraint(); constraint != "" {
+			constraints = append(constraints, constraint)
+		}
+	}
+
+	return constraints
+}
+
+// FlagGroups returns the groups the provided flag belongs to.
+func FlagGroups(flag cli.Flag) []*cli.FlagGroup {
+	var groups []*cli.FlagGroup
+
+	for flag != nil {
+		if get, ok := flag.(cli.FlagGrouped); ok {
+			groups = append(groups, get.FlagGroups()...)
+		}
+
+		wrapper, ok := flag.(cli.FlagWrapper)
+		if !ok {
+			break
+		}
+
+		flag = wrapper.Unwrap()
+	}
+
+	return groups
+}
+
+// FlagDescription returns the description of the flag to display in help,
+// completed with the constraints of its value, its environment variables, its requirements and the constraints of its groups.
+func FlagDescription(flag cli.Flag) string { return flagDescription(flag, true) }
+
+// FlagDescriptionWithoutEnv returns the description of the flag like FlagDescription does, without
+// its environment variables, for documentations listing them separately.
+func FlagDescriptionWithoutEnv(flag cli.Flag) string { return flagDescription(flag, false) }
+
+func flagDescription(flag cli.Flag, withEnv bool) string {
+	description := flag.Description()
+
+	if constraints := FlagConstraints(flag); len(constraints) > 0 {
+		description += " (" + strings.Join(constraints, ", ") + ")"
+	}
+
+	if names := FlagEnvNames(flag); len(names) > 0 && withEnv {
+		description += " [$" + strings.Join(names, ", $") + "]"
+	}
+
+	if FlagRequired(flag) {
+		description += " (required)"
+	}
+
+	name := FlagName(flag)
+
+	for _, group := range FlagGroups(flag) {
+		names := flagNames(group.Flags)
+
+		switch others := slices.DeleteFunc(slices.Clone(names), func(other string) bool { return other == name }); group.Constraint {
+		case cli.FlagGroupMutuallyExclusive:
+			description += " (mutually exclusive with " + strings.Join(others, ", ") + ")"
+		case cli.FlagGroupRequiredTogether:
+			description += " (requires " + strings.Join(others, ", ") + ")"
+		case cli.FlagGroupOneRequired:
+			description += " (at least one of " + strings.Join(names, ", ") + " is required)"
+		}
+	}
+
+	return strings.TrimSpace(description)
+}
+
+// FlagName returns the name to use to refer to the flag in messages, like --long or -s.
+func FlagName(flag cli.Flag) string {
+	if flag.LongName() != "" {
+		return "--" + flag.LongName()
+	}
+
+	return "-" + flag.ShortName()
+}
+
+// flagNames returns the names of the provided flags, as returned by FlagName.
+func flagNames(flags []cli.Flag) []string {
+	names := make([]string, len(flags))
+	for i, flag := range flags {
+		names[i] = FlagName(flag)
+	}
+
+	return names
+}
+
+// NewFlagsCheck returns a function checking that all the required flags among the provided
+// ones are set, that the constraints of the groups they belong to are respected, and that
+// the values of the flags are accepted by their validators, default values included.
+// Flags are provided when they have a source (see cli.GetFlagSource), or when the configuration
+// loaded in the context provided their value (see cli.IsConfigProvidedInContext).
+// All the violations are reported at once, in an error requesting the help to be shown.
+func NewFlagsCheck(flags []cli.Flag) func(ctx context.Context) error {
+	var (
+		required  []cli.Flag
+		validated []cli.Flag
+		groups    []*cli.FlagGroup
+	)
+
+	for _, flag := range flags {
+		if FlagRequired(flag) {
+			required = append(required, flag)
+		}
+
+		if len(FlagValidators(flag)) > 0 {
+			validated = append(validated, flag)
+		}
+
+		for _, group := range FlagGroups(flag) {
+			if !slices.Contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	return func(ctx context.Context) error {
+		provided := func(flag cli.Flag) bool {
+			return cli.GetFlagSource(flag) != "" || cli.IsConfigProvidedInContext(ctx, flag.Destination())
+		}
+
+		var errs []error
+
+		if missing := slices.DeleteFunc(slices.Clone(required), provided); len(missing) == 1 {
+			errs = append(errs, fmt.Errorf("missing required flag %s", FlagName(missing[0])))
+		} else if len(missing) > 1 {
+			errs = append(errs, fmt.Errorf("missing required flags %s", strings.Join(flagNames(missing), ", ")))
+		}
+
+		for _, group := range groups {
+			if err := checkFlagGroup(group, provided); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		for _, flag := range validated {
+			if err := validateFlag(flag); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return cli.NewErrorWithHelp(err)
+		}
+
+		return nil
+	}
+}
+
+// validateFlag checks the value of the provided flag against its validators.
+func validateFlag(flag cli.Flag) error {
+	var errs []error
+
+	for _, validator := range FlagValidators(flag) {
+		if err := validator.Validate(flag.Destination()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid value for flag %s: %w", FlagName(flag), err)
+	}
+
+	return nil
+}
+
+// checkFlagGroup checks the constraint of the provided group, given the way to know whether a flag is provided.
+func checkFlagGroup(group *cli.FlagGroup, provided func(cli.Flag) bool) error {
+	var set, unset []cli.Flag
+
+	for _, flag := range group.Flags {
+		if provided(flag) {
+			set = append(set, flag)
+		} else {
+			unset = append(unset, flag)
+		}
+	}
+
+	switch group.Constraint {
+	case cli.FlagGroupMutuallyExclusive:
+		if len(set) > 1 {
+			return fmt.Errorf("flags %s are mutually exclusive", strings.Join(flagNames(set), ", "))
+		}
+	case cli.FlagGroupRequiredTogether:
+		if len(set) > 0 && len(unset) > 0 {
+			return fmt.Errorf("flags %s are required together: missing %s", strings.Join(flagNames(group.Flags), ", "), strings.Join(flagNames(unset), ", "))
+		}
+	case cli.FlagGroupOneRequired:
+		if len(set) == 0 {
+			return fmt.Errorf("at least one of flags %s is required", strings.Join(flagNames(group.Flags), ", "))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package mapper
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+)
+
+func Test_FlagRequired(t *testing.T) {
+	var s string
+
+	test.Assert(t, !FlagRequired(cli.NewBuiltinFlag("long", "", &s, "")))
+	test.Assert(t, FlagRequired(cli.NewBuiltinFlag("long", "", &s, "", cli.WithRequired())))
+	test.Assert(t, FlagRequired(cli.MutuallyExclusiveFlags(
+		cli.NewBuiltinFlag("a", "", &s, "", cli.WithRequired()),
+		cli.NewBuiltinFlag("b", "", &s, ""),
+	)[0]))
+}
+
+func Test_FlagCandidates(t *testing.T) {
+	var s string
+
+	test.Assert(t, FlagCandidates(cli.NewBuiltinFlag("a", "", &s, "")) == nil)
+
+	candidates := FlagCandidates(cli.MutuallyExclusiveFlags(
+		cli.NewEnumFlag("a", "", &s, []string{"x", "y"}, ""),
+		cli.NewBuiltinFlag("b", "", &s, ""),
+	)[0])
+	test.Assert(t, len(candidates) == 2 && candidates[0] == "x" && candidates[1] == "y")
+}
+
+func Test_FlagCounter(t *testing.T) {
+	var i int
+
+	test.Assert(t, !FlagCounter(cli.NewBuiltinFlag("a", "", &i, "")))
+	test.Assert(t, FlagCounter(cli.RequiredTogetherFlags(
+		cli.NewCounterFlag("a", "", &i, ""),
+		cli.NewBuiltinFlag("b", "", &i, ""),
+	)[0]))
+}
+
+func Test_FlagExpectsValue(t *testing.T) {
+	var (
+		i int
+		b bool
+	)
+
+	test.Assert(t, FlagExpectsValue(cli.NewBuiltinFlag("a", "", &i, "")))
+	test.Assert(t, !FlagExpectsValue(cli.NewBuiltinFlag("a", "", &b, "")))
+	test.Assert(t, !FlagExpectsValue(cli.NewCounterFlag("a", "", &i, "")))
+}
+
+func Test_FlagHidden(t *testing.T) {
+	var s string
+
+	test.Assert(t, !FlagHidden(cli.NewBuiltinFlag("a", "", &s, "")))
+	test.Assert(t, FlagHidden(cli.NewBuiltinFlag("a", "", &s, "", cli.WithHidden())))
+	test.Assert(t, FlagHidden(cli.NewEnumFlag("a", "", &s, []string{"x"}, "", cli.WithDeprecated("use --b instead"))))
+}
+
+func Test_FlagHelpGroup(t *testing.T) {
+	var s string
+
+	test.Assert(t, FlagHelpGroup(cli.NewBuiltinFlag("a", "", &s, "")) == "")
+	test.Assert(t, FlagHelpGroup(cli.MutuallyExclusiveFlags(cli.NewBuiltinFlag("a", "", &s, "", cli.WithHelpGroup("Output")), cli.NewBuiltinFlag("b", "", &s, ""))[0]) == "Output")
+}
+
+func Test_FlagDeprecation(t *testing.T) {
+	var s string
+
+	test.Assert(t, FlagDeprecation(cli.NewBuiltinFlag("a", "", &s, "")) == "")
+	test.Assert(t, FlagDeprecation(cli.NewEnumFlag("a", "", &s, []string{"x"}, "", cli.WithDeprecated("use --b instead"))) == "use --b instead")
+}
+
+func Test_FlagDefaultString(t *testing.T) {
+	s := "default"
+
+	flag := cli.NewEnumFlag("a", "", &s, []string{"default", "other"}, "")
+	test.Require(t, flag.FromString("other") == nil)
+	test.Assert(t, FlagDefaultString(flag) == "default")
+}
+
+func Test_FlagDocumentedDefault(t *testing.T) {
+	var (
+		i int
+		s = "foo"
+	)
+
+	test.Assert(t, FlagDocumentedDefault(cli.NewBuiltinFlag("a", "", &i, "")) == "")
+	test.Assert(t, FlagDocumentedDefault(cli.NewBuiltinFlag("a", "", &s, "")) == "foo")
+	test.Assert(t, FlagDocumentedDefault(cli.NewBuiltinFlag("a", "", &s, "", cli.WithRequired())) == "")
+}
+
+func Test_ResetFlags(t *testing.T) {
+	var (
+		s = "default"
+		i int
+	)
+
+	flags := FlagsWithAliases([]cli.Flag{
+		cli.NewEnumFlag("a", "", &s, []string{"default", "other"}, "", cli.WithAliases("aa")),
+		cli.NewCounterFlag("b", "", &i, ""),
+	})
+	test.Require(t, flags[1].FromString("other") == nil && flags[2].FromString("+1") == nil)
+
+	ResetFlags(flags)
+	test.Assert(t, s == "default" && i == 0)
+	test.Assert(t, !flags[0].IsSet() && !flags[1].IsSet() && !flags[2].IsSet())
+}
+
+func Test_FlagConstraints(t *testing.T) {
+	var i int
+
+	test.Assert(t, len(FlagConstraints(cli.NewBuiltinFlag("a", "", &i, ""))) == 0)
+	test.Assert(t, slices.Equal(FlagConstraints(cli.NewCounterFlag("a", "", &i, "", cli.WithValidators(
+		cli.InRange(0, 3),
+		cli.NewValidator("", func(int) error { return nil }),
+	))), []string{"0-3"}))
+}
+
+func Test_FlagGroups(t *testing.T) {
+	var s string
+
+	test.Assert(t, len(FlagGroups(cli.NewBuiltinFlag("a", "", &s, ""))) == 0)
+
+	flags := cli.OneRequiredFlags(cli.MutuallyExclusiveFlags(
+		cli.NewBuiltinFlag("a", "", &s, ""),
+		cli.NewBuiltinFlag("b", "", &s, ""),
+	)...)
+
+	groups := FlagGroups(flags[0])
+	test.Require(t, len(groups) == 2)
+	test.Assert(t, groups[0].Constraint == cli.FlagGroupOneRequired)
+	test.Assert(t, groups[1].Constraint == cli.FlagGroupMutuallyExclusive)
+}
+
+func Test_FlagDescription(t *testing.T) {
+	var s string
+
+	test.Assert(t, FlagDescription(cli.NewBuiltinFlag("long", "", &s, "descr")) == "descr")
+	test.Assert(t, FlagDescription(cli.NewBuiltinFlag("long", "", &s, "descr", cli.WithRequired())) == "descr (required)")
+	test.Assert(t, FlagDescription(cli.NewBuiltinFlag("long", "", &s, "", cli.WithRequired())) == "(required)")
+	test.Assert(t, FlagDescription(cli.NewBuiltinFlag("long", "", &s, "descr", cli.WithRequired(), cli.WithValidators(
+		cli.LengthBetween(1, 8), cli.NotEmpty(),
+	))) == "descr (1-8 characters, non-empty) (required)")
+	test.Assert(t, FlagDescription(cli.NewBuiltinFlag("long", "", &s, "descr", cli.WithRequired(), cli.WithEnv("APP_LONG", "LONG"))) == "descr [$APP_LONG, $LONG] (required)")
+	test.Assert(t, FlagDescriptionWithoutEnv(cli.NewBuiltinFlag("long", "", &s, "descr", cli.WithRequired(), cli.WithEnv("APP_LONG"))) == "descr (required)")
+
+	exclusive := cli.MutuallyExclusiveFlags(
+		cli.NewBuiltinFlag("a", "", &s, "descr"),
+		cli.NewBuiltinFlag("", "b", &s, ""),
+		cli.NewBuiltinFlag("c", "", &s, ""),
+	)
+	test.Assert(t, FlagDescription(exclusive[0]) == "descr (mutually exclusive with -b, --c)", FlagDescription(exclusive[0]))
+
+	together := cli.RequiredTogetherFlags(cli.NewBuiltinFlag("a", "", &s, "descr"), cli.NewBuiltinFlag("b", "", &s, ""))
+	test.Assert(t, FlagDescription(together[1]) == "(requires --a)", FlagDescription(together[1]))
+
+	oneRequired := cli.OneRequiredFlags(cli.NewBuiltinFlag("a", "", &s, "descr"), cli.NewBuiltinFlag("b", "", &s, ""))
+	test.Assert(t, FlagDescription(oneRequired[0]) == "descr (at least one of --a, --b is required)", FlagDescription(oneRequired[0]))
+}
+
+func Test_FlagName(t *testing.T) {
+	var s string
+
+	test.Assert(t, FlagName(cli.NewBuiltinFlag("long", "s", &s, "")) == "--long")
+	test.Assert(t, FlagName(cli.NewBuiltinFlag("", "s", &s, "")) == "-s")
+}
+
+func Test_NewFlagsCheck(t *testing.T) {
+	t.Run("no required flags", func(t *testing.T) {
+		var s string
+		test.Assert(t, NewFlagsCheck([]cli.Flag{cli.NewBuiltinFlag("a", "", &s, "")})(t.Context()) == nil)
+	})
+
+	t.Run("required flags are set", func(t *testing.T) {
+		var a, b string
+
+		flags := []cli.Flag{
+			cli.NewBuiltinFlag("a", "", &a, "", cli.WithRequired()),
+			cli.NewBuiltinFlag("b", "", &b, ""),
+		}
+		check := NewFlagsCheck(flags)
+		test.Require(t, flags[0].FromString("value") == nil)
+
+		test.Assert(t, check(t.Context()) == nil)
+	})
+
+	t.Run("required flags are missing", func(t *testing.T) {
+		var a, b, c string
+
+		check := NewFlagsCheck([]cli.Flag{
+			cli.NewBuiltinFlag("a", "", &a, "", cli.WithRequired()),
+			cli.NewBuiltinFlag("", "b", &b, "", cli.WithRequired()),
+			cli.NewBuiltinFlag("c", "", &c, ""),
+		})
+
+		err := check(t.Context())
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == "missing required flags --a, -b", err.Error())
+
+		var showHelpErr cli.ShowHelpError
+		test.Assert(t, errors.As(err, &showHelpErr) && showHelpErr.ShowHelp())
+	})
+
+	t.Run("required flag provided by configuration", func(t *testing.T) {
+		a := "default"
+
+		check := NewFlagsCheck([]cli.Flag{cli.NewBuiltinFlag("a", "", &a, "", cli.WithRequired())})
+
+		ctx := cli.NewCommandContext(t.Context())
+		err := check(ctx)
+		test.Assert(t, err != nil && err.Error() == "missing required flag --a")
+
+		cli.SetConfigProvidedInContext(ctx, &a)
+		test.Assert(t, check(ctx) == nil, "values equal to the default values are provided")
+	})
+
+	t.Run("validators", func(t *testing.T) {
+		var (
+			port  = 8080
+			name  = "bob"
+			names []string
+		)
+
+		flags := []cli.Flag{
+			cli.NewBuiltinFlag("port", "", &port, "", cli.WithValidators(cli.InRange(1, 65535))),
+			cli.NewBuiltinFlag("name", "", &name, "", cli.WithValidators(cli.LengthBetween(2, 4))),
+			cli.NewBuiltinSliceFlag("names", "", &names, "", cli.WithValidators(cli.NotEmpty())),
+		}
+		check := NewFlagsCheck(flags)
+
+		err := check(t.Context())
+		test.Assert(t, err != nil && err.Error() == "invalid value for flag --names: must not be empty", "default values are validated: %v", err)
+
+		test.Require(t, flags[0].FromString("0") == nil && flags[1].FromString("x") == nil && flags[2].FromString("bob") == nil)
+
+		err = check(t.Context())
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == "invalid value for flag --port: 0 is not between 1 and 65535\n"+
+			`invalid value for flag --name: "x" has 1 characters, must be between 2 and 4`, err.Error())
+
+		var showHelpErr cli.ShowHelpError
+		test.Assert(t, errors.As(err, &showHelpErr) && showHelpErr.ShowHelp())
+
+		test.Require(t, flags[0].FromString("8080") == nil && flags[1].FromString("bob") == nil)
+		test.Assert(t, check(t.Context()) == nil)
+	})
+
+	t.Run("flag groups", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			group         func(...cli.Flag) []cli.Flag
+			set           []int
+			expectedError string
+		}{
+			"mutually exclusive ok":       {group: cli.MutuallyExclusiveFlags, set: []int{1}},
+			"mutually exclusive none set": {group: cli.MutuallyExclusiveFlags},
+			"mutually exclusive violated": {group: cli.MutuallyExclusiveFlags, set: []int{0, 2}, expectedError: "flags --a, --c are mutually exclusive"},
+			"required together all set":   {group: cli.RequiredTogetherFlags, set: []int{0, 1, 2}},
+			"required together none set":  {group: cli.RequiredTogetherFlags},
+			"required together violated":  {group: cli.RequiredTogetherFlags, set: []int{1}, expectedError: "flags --a, --b, --c are required together: missing --a, --c"},
+			"one required ok":             {group: cli.OneRequiredFlags, set: []int{0, 1}},
+			"one required violated":       {group: cli.OneRequiredFlags, expectedError: "at least one of flags --a, --b, --c is required"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var a, b, c string
+
+				flags := tt.group(
+					cli.NewBuiltinFlag("a", "", &a, ""),
+					cli.NewBuiltinFlag("b", "", &b, ""),
+					cli.NewBuiltinFlag("c", "", &c, ""),
+				)
+				check := NewFlagsCheck(flags)
+
+				for _, i := range tt.set {
+					test.Require(t, flags[i].FromString("value") == nil)
+				}
+
+				err := check(t.Context())
+				if tt.expectedError == "" {
+					test.Assert(t, err == nil, "%v", err)
+					return
+				}
+
+				test.Require(t, err != nil)
+				test.Assert(t, err.Error() == tt.expectedError, err.Error())
+
+				var showHelpErr cli.ShowHelpError
+				test.Assert(t, errors.As(err, &showHelpErr) && showHelpErr.ShowHelp())
+			})
+		}
+	})
+
+	t.Run("all violations are reported", func(t *testing.T) {
+		var a, b, c string
+
+		flags := append(
+			cli.MutuallyExclusiveFlags(cli.NewBuiltinFlag("a", "", &a, ""), cli.NewBuiltinFlag("b", "", &b, "")),
+			cli.NewBuiltinFlag("c", "", &c, "", cli.WithRequired()),
+		)
+		check := NewFlagsCheck(flags)
+		test.Require(t, flags[0].FromString("a") == nil && flags[1].FromString("b") == nil)
+
+		err := check(t.Context())
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == "missing required flag --c\nflags --a, --b are mutually exclusive", err.Error())
+	})
+}
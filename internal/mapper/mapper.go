@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"strings"
 
@@ -15,13 +16,44 @@ import (
 // the `Context` method is called to obtain a customized context.
 // Otherwise, the original `ctx` is returned.
 func Context(cmd cli.Command, ctx context.Context) context.Context { //nolint:revive // context-as-argument is voluntarily not respected here to follow other commands signature.
-	if get, ok := cmd.(cli.CommandContext); ok {
+	if get, ok := cli.AsCommand[cli.CommandContext](cmd); ok {
 		return get.Context(ctx)
 	}
 
 	return ctx
 }
 
+// Aliases checks whenever command implements interface, with safe default value.
+// If the provided `cmd` implements the `cli.CommandAliases` interface,
+// its `Aliases` method is called, and the returned slice of strings is used.
+// Otherwise, nil is returned.
+func Aliases(cmd cli.Command) []string {
+	if get, ok := cli.AsCommand[cli.CommandAliases](cmd); ok {
+		return get.Aliases()
+	}
+
+	return nil
+}
+
+// CheckSubCommandsNames ensures that every subcommand of the provided `c`
+// can be unambiguously invoked: names and aliases of sibling commands
+// must not collide with one another.
+func CheckSubCommandsNames(c *cli.CLI) error {
+	owners := make(map[string]string)
+
+	for _, sub := range c.SubCommands {
+		for _, name := range append([]string{sub.Name}, Aliases(sub.Command)...) {
+			if owner, exists := owners[name]; exists {
+				return fmt.Errorf("name %q of command %s collides with command %s", name, sub.Name, owner)
+			}
+
+			owners[name] = sub.Name
+		}
+	}
+
+	return nil
+}
+
 // ShortDescription checks whenever command implements interface, with safe default value.
 // If the provided `cmd` implements the `cli.CommandDescription` interface,
 // its `Description` method is called, and the first line of the description
@@ -42,7 +74,7 @@ func ShortDescription(cmd cli.Command) string {
 // its `Description` method is called, and the returned value is used.
 // Otherwise, an empty string is returned.
 func Description(cmd cli.Command) string {
-	if get, ok := cmd.(cli.CommandDescription); ok {
+	if get, ok := cli.AsCommand[cli.CommandDescription](cmd); ok {
 		return get.Description()
 	}
 
@@ -54,7 +86,7 @@ func Description(cmd cli.Command) string {
 // its `Examples` method is called, and the returned slice of strings is used.
 // Otherwise, nil is returned.
 func Examples(cmd cli.Command) []string {
-	if get, ok := cmd.(cli.CommandExamples); ok {
+	if get, ok := cli.AsCommand[cli.CommandExamples](cmd); ok {
 		return get.Examples()
 	}
 
@@ -64,21 +96,37 @@ func Examples(cmd cli.Command) []string {
 // Usage checks whenever command implements interface, with safe default value.
 // If the provided `cmd` implements the `cli.CommandUsage` interface,
 // its `Usage` method is called, and the returned value is used.
-// Otherwise, an empty string is returned.
+// Otherwise, the usage is generated from the command arguments, if any.
 func Usage(cmd cli.Command) string {
-	if get, ok := cmd.(cli.CommandUsage); ok {
+	if get, ok := cli.AsCommand[cli.CommandUsage](cmd); ok {
 		return get.Usage()
 	}
 
+	if spec := Args(cmd); spec != nil {
+		return spec.Usage()
+	}
+
 	return ""
 }
 
+// Args checks whenever command implements interface, with safe default value.
+// If the provided `cmd` implements the `cli.CommandArgs` interface,
+// its `Args` method is called, and the returned `*cli.ArgsSpec` is used.
+// Otherwise, nil is returned.
+func Args(cmd cli.Command) *cli.ArgsSpec {
+	if get, ok := cli.AsCommand[cli.CommandArgs](cmd); ok {
+		return get.Args()
+	}
+
+	return nil
+}
+
 // Flags checks whenever command implements interface, with safe default value.
 // If the provided `cmd` implements the `cli.CommandFlags` interface,
 // its `Flags` method is called, and the returned slice of `cli.Flag` is used.
 // Otherwise, nil is returned.
 func Flags(cmd cli.Command) []cli.Flag {
-	if get, ok := cmd.(cli.CommandFlags); ok {
+	if get, ok := cli.AsCommand[cli.CommandFlags](cmd); ok {
 		return get.Flags()
 	}
 
@@ -90,7 +138,7 @@ func Flags(cmd cli.Command) []cli.Flag {
 // its `PersistentFlags` method is called, and the returned slice of `cli.Flag` is used.
 // Otherwise, nil is returned.
 func PersistentFlags(cmd cli.Command) []cli.Flag {
-	if get, ok := cmd.(cli.CommandPersistentFlags); ok {
+	if get, ok := cli.AsCommand[cli.CommandPersistentFlags](cmd); ok {
 		return get.PersistentFlags()
 	}
 
@@ -107,7 +155,7 @@ func PersistentFlags(cmd cli.Command) []cli.Flag {
 func Hook(cmd cli.Command) *cli.Hook {
 	var hooks *cli.Hook
 
-	if get, ok := cmd.(cli.CommandHook); ok {
+	if get, ok := cli.AsCommand[cli.CommandHook](cmd); ok {
 		hooks = get.Hook()
 	}
 
@@ -139,7 +187,7 @@ func Hook(cmd cli.Command) *cli.Hook {
 func PersistentHook(cmd cli.Command) *cli.PersistentHook {
 	var hooks *cli.PersistentHook
 
-	if get, ok := cmd.(cli.CommandPersistentHook); ok {
+	if get, ok := cli.AsCommand[cli.CommandPersistentHook](cmd); ok {
 		hooks = get.PersistentHook()
 	}
 
@@ -23,6 +23,55 @@ func Test_Context(t *testing.T) {
 	})
 }
 
+func Test_Aliases(t *testing.T) {
+	t.Run("implemented", func(t *testing.T) {
+		test.Assert(check.Compare(t, Aliases(new(commandWithAll)), []string{"alias"}))
+	})
+
+	t.Run("not implemented", func(t *testing.T) {
+		test.Assert(check.Compare(t, Aliases(new(commandSimple)), []string(nil)))
+	})
+}
+
+func Test_CheckSubCommandsNames(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		c := cli.New(new(commandSimple)).
+			AddCommand("foo", new(commandSimple)).
+			AddCommand("bar", new(commandWithAll))
+		test.Assert(t, CheckSubCommandsNames(c) == nil)
+	})
+
+	t.Run("names collide", func(t *testing.T) {
+		c := cli.New(new(commandSimple)).
+			AddCommand("foo", new(commandSimple)).
+			AddCommand("foo", new(commandSimple))
+
+		err := CheckSubCommandsNames(c)
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == `name "foo" of command foo collides with command foo`)
+	})
+
+	t.Run("alias collides with name", func(t *testing.T) {
+		c := cli.New(new(commandSimple)).
+			AddCommand("alias", new(commandSimple)).
+			AddCommand("foo", new(commandWithAll))
+
+		err := CheckSubCommandsNames(c)
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == `name "alias" of command foo collides with command alias`)
+	})
+
+	t.Run("aliases collide", func(t *testing.T) {
+		c := cli.New(new(commandSimple)).
+			AddCommand("foo", new(commandWithAll)).
+			AddCommand("bar", new(commandWithAll))
+
+		err := CheckSubCommandsNames(c)
+		test.Require(t, err != nil)
+		test.Assert(t, err.Error() == `name "alias" of command bar collides with command foo`)
+	})
+}
+
 func Test_ShortDescription(t *testing.T) {
 	t.Run("implemented", func(t *testing.T) {
 		test.Assert(t, ShortDescription(new(commandWithAll)) == "short description")
@@ -58,11 +107,27 @@ func Test_Usage(t *testing.T) {
 		test.Assert(t, Usage(new(commandWithAll)) == "usage")
 	})
 
+	t.Run("generated from args", func(t *testing.T) {
+		test.Assert(t, Usage(new(commandWithArgs)) == "<name> [arg...]")
+	})
+
 	t.Run("not implemented", func(t *testing.T) {
 		test.Assert(t, Usage(new(commandSimple)) == "")
 	})
 }
 
+func Test_Args(t *testing.T) {
+	t.Run("implemented", func(t *testing.T) {
+		spec := Args(new(commandWithArgs))
+		test.Require(t, spec != nil && spec.Positional != nil)
+		test.Assert(t, spec.Positional.Usage() == "<name> [arg...]")
+	})
+
+	t.Run("not implemented", func(t *testing.T) {
+		test.Assert(t, Args(new(commandSimple)) == nil)
+	})
+}
+
 func Test_Flags(t *testing.T) {
 	t.Run("implemented", func(t *testing.T) {
 		f := Flags(new(commandWithAll))
@@ -145,12 +210,25 @@ func (commandSimple) Execute(context.Context, []string, []string) error {
 	return nil
 }
 
+type commandWithArgs struct{ commandSimple }
+
+func (commandWithArgs) Args() *cli.ArgsSpec {
+	return &cli.ArgsSpec{Positional: &cli.ArgList{
+		Args:     []cli.Arg{cli.NewArgWithValuer("name", nil, "")},
+		Variadic: cli.NewArgWithValuer("arg", nil, ""),
+	}}
+}
+
 type commandWithAll struct{}
 
 func (commandWithAll) Execute(context.Context, []string, []string) error {
 	return nil
 }
 
+func (commandWithAll) Aliases() []string {
+	return []string{"alias"}
+}
+
 func (commandWithAll) Usage() string {
 	return "usage"
 }
@@ -1,4 +1,8 @@
-package mapper
+// Package testwriter provides an io.Writer forwarding CLI output to the test logs.
+//
+// It lives in its own package so mappers can use it without pulling the test
+// dependencies in the binaries built with the other mapper helpers.
+package testwriter
 
 import (
 	"io"
@@ -7,7 +11,7 @@ import (
 	testlogging "github.com/krostar/test/logging"
 )
 
-// TestWriter creates an io.Writer implementation suitable for testing CLI output.
+// New creates an io.Writer implementation suitable for testing CLI output.
 // It wraps the provided test.TestingT with a prefixed writer to help identify
 // CLI-specific output in test logs by adding a "[CLI]: " prefix to all written data.
 //
@@ -18,7 +22,7 @@ import (
 //
 // The returned writer will forward all data to the test logging system while
 // ensuring proper test helper marking for accurate test failure reporting.
-func TestWriter(t test.TestingT) io.Writer {
+func New(t test.TestingT) io.Writer {
 	return &writerWithPrefix{
 		t:      t,
 		w:      testlogging.NewWriter(t),
@@ -0,0 +1,96 @@
+package mapper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/krostar/cli"
+)
+
+// CommandNode is a command of the tree being walked by WalkCommands, with the flags it can use.
+type CommandNode struct {
+	// CLI is the command, its name being the program name for the root.
+	CLI *cli.CLI
+	// Parent is the parent command, nil for the root.
+	Parent *CommandNode
+	// Path is the names of the commands leading to this one, from the root, this one included.
+	Path []string
+
+	// Flags are the flags of the command, its persistent flags excluded.
+	Flags []cli.Flag
+	// PersistentFlags are the persistent flags of the command.
+	PersistentFlags []cli.Flag
+	// InheritedFlags are the persistent flags of the parents, the nearest parents first.
+	InheritedFlags []cli.Flag
+}
+
+// Hidden returns whether the command or one of its parents is hidden from help, see CommandHidden.
+func (node *CommandNode) Hidden() bool {
+	for ; node.Parent != nil; node = node.Parent {
+		if CommandHidden(node.CLI.Command) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WalkCommands calls fn for each command of the provided tree, parents before their subcommands,
+// in the order the subcommands are defined, with the context of the command.
+//
+// Commands are entered like mappers do when they build their commands: the context of each command
+// is created from the context of its parent, the BeforeFlagsDefinition persistent hook of the
+// command runs, and its flags are created only then. Other hooks never run, and no argument is
+// parsed. Flags aliases are not part of the flags of the commands.
+//
+// The packages describing CLIs without executing them, like the documentation generators, the
+// schema and the completion, walk commands this way so their hooks and flags behave like they do
+// during executions.
+func WalkCommands(ctx context.Context, c *cli.CLI, fn func(ctx context.Context, node *CommandNode) error) error {
+	return walkCommands(ctx, c, nil, fn)
+}
+
+func walkCommands(ctx context.Context, c *cli.CLI, parent *CommandNode, fn func(ctx context.Context, node *CommandNode) error) error {
+	ctx, node, err := enterCommand(ctx, c, parent)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(ctx, node); err != nil {
+		return err
+	}
+
+	for _, sub := range c.SubCommands {
+		if err := walkCommands(ctx, sub, node, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// enterCommand returns the context and the node of the provided command, entered the way WalkCommands describes.
+func enterCommand(ctx context.Context, c *cli.CLI, parent *CommandNode) (context.Context, *CommandNode, error) {
+	ctx = cli.NewCommandContext(ctx)
+	ctx = Context(c.Command, ctx)
+
+	if err := PersistentHook(c.Command).BeforeFlagsDefinition(ctx); err != nil {
+		return nil, nil, fmt.Errorf("pre-flag-definition hook of command %s failed: %w", c.Name, err)
+	}
+
+	node := &CommandNode{
+		CLI:             c,
+		Parent:          parent,
+		Path:            []string{c.Name},
+		Flags:           Flags(c.Command),
+		PersistentFlags: PersistentFlags(c.Command),
+	}
+
+	if parent != nil {
+		node.Path = append(slices.Clone(parent.Path), c.Name)
+		node.InheritedFlags = slices.Concat(parent.PersistentFlags, parent.InheritedFlags)
+	}
+
+	return ctx, node, nil
+}
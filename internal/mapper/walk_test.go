@@ -0,0 +1,98 @@
+package mapper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+)
+
+func Test_WalkCommands(t *testing.T) {
+	var a, b, c, d string
+
+	newCLI := func() *cli.CLI {
+		return cli.New(double.NewFake(
+			double.FakeWithPersistentFlags(func() []cli.Flag { return []cli.Flag{cli.NewBuiltinFlag("a", "", &a, "")} }),
+		)).
+			AddCommand("foo", double.NewFake(
+				double.FakeWithFlags(func() []cli.Flag { return []cli.Flag{cli.NewBuiltinFlag("b", "", &b, "")} }),
+				double.FakeWithPersistentFlags(func() []cli.Flag { return []cli.Flag{cli.NewBuiltinFlag("c", "", &c, "")} }),
+			)).
+			Mount("bar", cli.New(double.NewFake()).AddCommand("baz", double.NewFake(
+				double.FakeWithFlags(func() []cli.Flag { return []cli.Flag{cli.NewBuiltinFlag("d", "", &d, "")} }),
+			)))
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		var visited []string
+
+		root := newCLI()
+		root.Name = "app"
+
+		err := WalkCommands(t.Context(), root, func(_ context.Context, node *CommandNode) error {
+			var parent string
+			if node.Parent != nil {
+				parent = node.Parent.CLI.Name
+			}
+
+			visited = append(visited, strings.Join([]string{
+				strings.Join(node.Path, " "),
+				parent,
+				strings.Join(flagNames(node.Flags), ","),
+				strings.Join(flagNames(node.PersistentFlags), ","),
+				strings.Join(flagNames(node.InheritedFlags), ","),
+			}, "|"))
+
+			return nil
+		})
+		test.Require(t, err == nil, err)
+		test.Assert(t, slices.Equal(visited, []string{
+			"app|||--a|",
+			"app foo|app|--b|--c|--a",
+			"app bar|app|||--a",
+			"app bar baz|bar|--d||--a",
+		}), visited)
+	})
+
+	t.Run("callback failure", func(t *testing.T) {
+		var visited int
+
+		err := WalkCommands(t.Context(), newCLI(), func(context.Context, *CommandNode) error {
+			visited++
+			return errors.New("boom")
+		})
+		test.Assert(t, err != nil && err.Error() == "boom" && visited == 1)
+	})
+
+	t.Run("hook failure", func(t *testing.T) {
+		root := cli.New(double.NewFake()).AddCommand("foo", double.NewFake(double.FakeWithPersistentHook(func() *cli.PersistentHook {
+			return &cli.PersistentHook{BeforeFlagsDefinition: func(context.Context) error { return errors.New("boom") }}
+		})))
+
+		err := WalkCommands(t.Context(), root, func(context.Context, *CommandNode) error { return nil })
+		test.Assert(t, err != nil && err.Error() == "pre-flag-definition hook of command foo failed: boom", err)
+	})
+}
+
+func Test_CommandNode_Hidden(t *testing.T) {
+	root := cli.New(double.NewFake()).
+		AddCommand("foo", double.NewFake()).
+		Mount("bar", cli.New(double.NewFake()).AddCommand("baz", double.NewFake()), cli.WithHiddenCommand())
+
+	var hidden []string
+
+	test.Require(t, WalkCommands(t.Context(), root, func(_ context.Context, node *CommandNode) error {
+		if node.Hidden() {
+			hidden = append(hidden, node.CLI.Name)
+		}
+
+		return nil
+	}) == nil)
+	test.Assert(t, slices.Equal(hidden, []string{"bar", "baz"}), hidden)
+}
@@ -3,20 +3,37 @@ package mapper
 import (
 	"context"
 	"errors"
+	"maps"
+	"os"
+	"path/filepath"
 	"slices"
+	"strings"
 	"testing"
 
 	"github.com/krostar/test"
 
 	"github.com/krostar/cli"
+	clicfg "github.com/krostar/cli/cfg"
+	sourceflag "github.com/krostar/cli/cfg/source/flag"
 	"github.com/krostar/cli/double"
 )
 
 // AssertImplementation provides a comprehensive test suite for CLI mapper implementations.
 // It validates that any mapper correctly handles all aspects of CLI execution including:
 // - CLI name resolution and preservation
+// - Optional command interfaces lookup with cli.AsCommand, the test doubles providing them through cli.CommandWrapper
 // - Error handling and propagation (including custom exit statuses and help requests)
 // - Flag parsing and inheritance across command hierarchies
+// - Required flags enforcement and flag values validation
+// - Flags set from their environment variables
+// - Completion requests of arguments and flag values
+// - Explicitly empty flag values, and flags reset to their default value between executions
+// - Flag values read from files and stdin
+// - Hidden and deprecated flags and commands
+// - Flag aliases resolution and collision detection
+// - Positional and dashed arguments splitting and validation, and arguments reset between executions
+// - Arguments specifications checked when commands are built
+// - Command aliases resolution and collision detection
 // - Hook execution order (persistent and command-specific hooks)
 // - Command structure navigation and execution
 // - Context propagation through the command chain
@@ -122,6 +139,20 @@ func AssertImplementation(t *testing.T, executeFunc func(*testing.T, []string, *
 		})
 	})
 
+	t.Run("optional command interfaces are looked up with cli.AsCommand", func(t *testing.T) {
+		var flag string
+
+		cmd := double.NewFake(double.FakeWithFlags(func() []cli.Flag {
+			return []cli.Flag{cli.NewBuiltinFlag("flag", "", &flag, "")}
+		}))
+
+		_, implemented := cmd.(cli.CommandFlags)
+		test.Require(t, !implemented, "doubles must provide optional interfaces through cli.CommandWrapper only")
+
+		err := executeFunc(t, []string{"myapp", "--flag", "value"}, cli.New(cmd))
+		test.Assert(t, err == nil && flag == "value", "optional command interfaces, like cli.CommandFlags, must be looked up with cli.AsCommand: %v", err)
+	})
+
 	t.Run("flags are correctly handled", func(t *testing.T) {
 		var (
 			flagStr  string
@@ -146,6 +177,1034 @@ func AssertImplementation(t *testing.T, executeFunc func(*testing.T, []string, *
 		test.Assert(t, flagBool)
 	})
 
+	t.Run("explicitly empty values are set", func(t *testing.T) {
+		for name, args := range map[string][]string{
+			"with equal sign":  {"myapp", "--str="},
+			"as next argument": {"myapp", "--str", ""},
+		} {
+			t.Run(name, func(t *testing.T) {
+				flagStr := "default"
+
+				var flag cli.Flag
+
+				err := executeFunc(t, args, cli.
+					New(double.NewFake(
+						double.FakeWithFlags(func() []cli.Flag {
+							flag = cli.NewBuiltinFlag("str", "s", &flagStr, "String flag")
+							return []cli.Flag{flag}
+						}),
+					)),
+				)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, flagStr == "" && flag.IsSet(), flagStr)
+			})
+		}
+	})
+
+	t.Run("flags are reset to their default value between executions", func(t *testing.T) {
+		for name, reuseFlags := range map[string]bool{
+			"flags created on each execution": false,
+			"flags reused across executions":  true,
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					name   string
+					count  int
+					tags   []string
+					labels map[string]string
+					flags  []cli.Flag
+				)
+
+				c := cli.New(double.NewFake(double.FakeWithFlags(func() []cli.Flag {
+					if !reuseFlags || flags == nil {
+						name, count, tags, labels = "default", 0, []string{"default"}, map[string]string{"default": "value"}
+						flags = []cli.Flag{
+							cli.NewBuiltinFlag("name", "n", &name, ""),
+							cli.NewCounterFlag("verbose", "v", &count, ""),
+							cli.NewBuiltinSliceFlag("tag", "t", &tags, "", cli.WithAppend(), cli.WithResetDefault()),
+							cli.NewBuiltinMapFlag("label", "l", &labels, "", cli.WithAliases("lbl")),
+						}
+					}
+
+					return flags
+				})))
+
+				err := executeFunc(t, []string{"myapp", "--name", "john", "-vv", "-t", "a", "--lbl", "a=1"}, c)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, name == "john" && count == 2 && slices.Equal(tags, []string{"a"}))
+				test.Assert(t, maps.Equal(labels, map[string]string{"a": "1"}), "%v", labels)
+
+				err = executeFunc(t, []string{"myapp", "-v", "-t", "b", "--lbl", "b=2"}, c)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, name == "default" && !flags[0].IsSet(), name)
+				test.Assert(t, count == 1, "%d", count)
+				test.Assert(t, slices.Equal(tags, []string{"b"}), "%v", tags)
+				test.Assert(t, maps.Equal(labels, map[string]string{"b": "2"}), "%v", labels)
+
+				err = executeFunc(t, []string{"myapp"}, c)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, name == "default" && count == 0, "%s %d", name, count)
+				test.Assert(t, slices.Equal(tags, []string{"default"}), "%v", tags)
+				test.Assert(t, maps.Equal(labels, map[string]string{"default": "value"}), "%v", labels)
+			})
+		}
+
+		t.Run("values assigned after flags creation are kept", func(t *testing.T) {
+			name := "default"
+			flags := []cli.Flag{cli.NewBuiltinFlag("name", "n", &name, "")}
+			c := cli.New(double.NewFake(double.FakeWithFlags(func() []cli.Flag { return flags })))
+
+			name = "assigned"
+			err := executeFunc(t, []string{"myapp"}, c)
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, name == "assigned", name)
+		})
+	})
+
+	t.Run("flag values can be read from files and stdin", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "password")
+		test.Require(t, os.WriteFile(path, []byte("s3cr3t\n"), 0o600) == nil)
+
+		for name, tc := range map[string]struct {
+			args             []string
+			expectedPassword string
+			expectedToken    string
+		}{
+			"file":            {args: []string{"myapp", "--password", "@" + path}, expectedPassword: "s3cr3t"},
+			"file with equal": {args: []string{"myapp", "--password=@" + path}, expectedPassword: "s3cr3t"},
+			"stdin":           {args: []string{"myapp", "--token", "-"}, expectedToken: "from stdin"},
+			"escaped value":   {args: []string{"myapp", "--password", "@@value", "-t", "value"}, expectedPassword: "@value", expectedToken: "value"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var password, token string
+
+				err := executeFunc(t, tc.args, cli.
+					New(double.NewFake(
+						double.FakeWithFlags(func() []cli.Flag {
+							return []cli.Flag{
+								cli.NewBuiltinFlag("password", "", &password, "", cli.WithFileValue()),
+								cli.NewBuiltinFlag("token", "t", &token, "", cli.WithFileValue(cli.WithStdin(strings.NewReader("from stdin\n")))),
+							}
+						}),
+					)),
+				)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, password == tc.expectedPassword, password)
+				test.Assert(t, token == tc.expectedToken, token)
+			})
+		}
+	})
+
+	t.Run("map flags can be repeated", func(t *testing.T) {
+		labels := map[string]string{"default": "value"}
+
+		err := executeFunc(t, []string{"myapp", "--label", "a=1,b=2", "-l", "c=3", "--label=d=4"}, cli.
+			New(double.NewFake(
+				double.FakeWithFlags(func() []cli.Flag {
+					return []cli.Flag{cli.NewBuiltinMapFlag("label", "l", &labels, "")}
+				}),
+			)),
+		)
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, maps.Equal(labels, map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"}), "%v", labels)
+	})
+
+	t.Run("slice flags can be repeated", func(t *testing.T) {
+		tags, names := []string{"default"}, []string{"default"}
+
+		err := executeFunc(t, []string{"myapp", "--tag", "a,b", "-t", "c", "--name", "doe, john", "--name=jane"}, cli.
+			New(double.NewFake(
+				double.FakeWithFlags(func() []cli.Flag {
+					return []cli.Flag{
+						cli.NewBuiltinSliceFlag("tag", "t", &tags, "", cli.WithAppend()),
+						cli.NewBuiltinSliceFlag("name", "", &names, "", cli.WithAppend(), cli.WithResetDefault(), cli.WithSeparator("")),
+					}
+				}),
+			)),
+		)
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, slices.Equal(tags, []string{"default", "a", "b", "c"}), "%v", tags)
+		test.Assert(t, slices.Equal(names, []string{"doe, john", "jane"}), "%v", names)
+	})
+
+	t.Run("counter flags count occurrences", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			args          []string
+			expectedCount int
+			expectedQuiet bool
+		}{
+			"bundled short flags": {args: []string{"-vvv"}, expectedCount: 3},
+			"repeated long flags": {args: []string{"--verbose", "--verbose"}, expectedCount: 2},
+			"explicit value":      {args: []string{"--verbose=3"}, expectedCount: 3},
+			"mixed":               {args: []string{"--verbose=2", "-vq", "--verbose"}, expectedCount: 4, expectedQuiet: true},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					count int
+					quiet bool
+				)
+
+				err := executeFunc(t, append([]string{"myapp"}, tc.args...), cli.
+					New(double.NewFake(
+						double.FakeWithFlags(func() []cli.Flag {
+							return []cli.Flag{
+								cli.NewCounterFlag("verbose", "v", &count, ""),
+								cli.NewBuiltinFlag("quiet", "q", &quiet, ""),
+							}
+						}),
+					)),
+				)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, count == tc.expectedCount, "%d", count)
+				test.Assert(t, quiet == tc.expectedQuiet)
+			})
+		}
+	})
+
+	t.Run("required flags are enforced", func(t *testing.T) {
+		newCLI := func(rootFlag, subFlag *string, hook *cli.Hook) *cli.CLI {
+			return cli.
+				New(double.NewFake(
+					double.FakeWithPersistentFlags(func() []cli.Flag {
+						return []cli.Flag{cli.NewBuiltinFlag("root", "", rootFlag, "", cli.WithRequired())}
+					}),
+				)).
+				AddCommand("sub", double.NewFake(
+					double.FakeWithFlags(func() []cli.Flag {
+						return []cli.Flag{cli.NewBuiltinFlag("sub", "s", subFlag, "", cli.WithRequired())}
+					}),
+					double.FakeWithHook(func() *cli.Hook { return hook }),
+				))
+		}
+
+		t.Run("all required flags are set", func(t *testing.T) {
+			var rootFlag, subFlag string
+
+			spy, spied := double.SpyCLI(newCLI(&rootFlag, &subFlag, nil))
+
+			err := executeFunc(t, []string{"app", "sub", "--root", "r", "-s", "s"}, spied)
+			test.Require(t, err == nil, "%v", err)
+			spy.AssertCommandMethodCalled(t, []string{spied.Name, "sub"}, "Execute", true)
+		})
+
+		t.Run("missing flags are all reported", func(t *testing.T) {
+			var rootFlag, subFlag string
+
+			spy, spied := double.SpyCLI(newCLI(&rootFlag, &subFlag, nil))
+
+			err := executeFunc(t, []string{"app", "sub"}, spied)
+			test.Require(t, err != nil)
+			test.Assert(t, strings.Contains(err.Error(), "missing required flags --sub, --root"), "%v", err)
+
+			var helpErr cli.ShowHelpError
+			test.Assert(t, errors.As(err, &helpErr) && helpErr.ShowHelp())
+			test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "sub"}, "Execute") == 0)
+		})
+
+		t.Run("flags loaded from configuration satisfy requirements", func(t *testing.T) {
+			type config struct{ Root, Sub string }
+
+			cfg := config{Sub: "from config"} // values provided by the configuration can be equal to the default values
+
+			spy, spied := double.SpyCLI(newCLI(&cfg.Root, &cfg.Sub, &cli.Hook{
+				BeforeCommandExecution: clicfg.BeforeCommandExecutionHook(&cfg, func(_ context.Context, cfg *config) error {
+					cfg.Sub = "from config"
+					return nil
+				}, sourceflag.Source(&cfg)),
+			}))
+
+			err := executeFunc(t, []string{"app", "sub", "--root", "r"}, spied)
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, cfg.Sub == "from config")
+			spy.AssertCommandMethodCalled(t, []string{spied.Name, "sub"}, "Execute", true)
+		})
+	})
+
+	t.Run("enum flags only accept allowed values", func(t *testing.T) {
+		newCLI := func(level *string) *cli.CLI {
+			return cli.New(double.NewFake(
+				double.FakeWithFlags(func() []cli.Flag {
+					return []cli.Flag{cli.NewEnumFlag("level", "", level, []string{"debug", "info"}, "")}
+				}),
+			))
+		}
+
+		t.Run("allowed value", func(t *testing.T) {
+			var level string
+
+			err := executeFunc(t, []string{"app", "--level", "info"}, newCLI(&level))
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, level == "info")
+		})
+
+		t.Run("unknown value", func(t *testing.T) {
+			var level string
+
+			spy, spied := double.SpyCLI(newCLI(&level))
+
+			err := executeFunc(t, []string{"app", "--level", "inof"}, spied)
+			test.Require(t, err != nil)
+			test.Assert(t, strings.Contains(err.Error(), `did you mean "info"?`), "%v", err)
+			test.Assert(t, level == "")
+			test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name}, "Execute") == 0)
+		})
+	})
+
+	t.Run("flag values are validated", func(t *testing.T) {
+		newCLI := func(port *int, tags *[]string) *cli.CLI {
+			return cli.
+				New(double.NewFake(
+					double.FakeWithPersistentFlags(func() []cli.Flag {
+						return []cli.Flag{cli.NewBuiltinFlag("port", "p", port, "", cli.WithValidators(cli.InRange(1, 65535)))}
+					}),
+				)).
+				AddCommand("sub", double.NewFake(
+					double.FakeWithFlags(func() []cli.Flag {
+						return []cli.Flag{cli.NewBuiltinSliceFlag("tag", "t", tags, "", cli.WithValidators(cli.NotEmpty(), cli.LengthBetween(1, 3)))}
+					}),
+				))
+		}
+
+		t.Run("valid values", func(t *testing.T) {
+			var (
+				port = 0
+				tags []string
+			)
+
+			err := executeFunc(t, []string{"app", "sub", "-p", "8080", "--tag", "a,bcd"}, newCLI(&port, &tags))
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, port == 8080 && slices.Equal(tags, []string{"a", "bcd"}))
+		})
+
+		t.Run("invalid values", func(t *testing.T) {
+			var (
+				port = 0
+				tags []string
+			)
+
+			spy, spied := double.SpyCLI(newCLI(&port, &tags))
+
+			err := executeFunc(t, []string{"app", "sub", "--port", "70000", "-t", "abcd"}, spied)
+			test.Require(t, err != nil)
+			test.Assert(t, strings.Contains(err.Error(), "invalid value for flag --port: 70000 is not between 1 and 65535"), "%v", err)
+			test.Assert(t, strings.Contains(err.Error(), `invalid value for flag --tag: "abcd" has 4 characters`), "%v", err)
+
+			var helpErr cli.ShowHelpError
+			test.Assert(t, errors.As(err, &helpErr) && helpErr.ShowHelp())
+			test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "sub"}, "Execute") == 0)
+		})
+
+		t.Run("default values are validated", func(t *testing.T) {
+			var (
+				port = 0
+				tags []string
+			)
+
+			spy, spied := double.SpyCLI(newCLI(&port, &tags))
+
+			err := executeFunc(t, []string{"app", "sub"}, spied)
+			test.Require(t, err != nil)
+			test.Assert(t, strings.Contains(err.Error(), "invalid value for flag --port: 0 is not between 1 and 65535"), "%v", err)
+			test.Assert(t, strings.Contains(err.Error(), "invalid value for flag --tag: must not be empty"), "%v", err)
+			test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "sub"}, "Execute") == 0)
+
+			validPort, validTags := 8080, []string{"a"}
+
+			err = executeFunc(t, []string{"app", "sub"}, newCLI(&validPort, &validTags))
+			test.Assert(t, err == nil, "%v", err)
+		})
+
+		t.Run("values that cannot be parsed name the flag once", func(t *testing.T) {
+			var (
+				port = 0
+				tags []string
+			)
+
+			err := executeFunc(t, []string{"app", "sub", "--port", "abc"}, newCLI(&port, &tags))
+			test.Require(t, err != nil)
+			test.Assert(t, strings.Count(err.Error(), "port") == 1, "%v", err)
+		})
+	})
+
+	t.Run("flags are set from their environment variables", func(t *testing.T) {
+		t.Setenv("CLI_ASSERT_PORT", "8080")
+		t.Setenv("CLI_ASSERT_NAME", "john")
+
+		newCLI := func(port *int, name, nameSourceInHook *string) *cli.CLI {
+			var flags []cli.Flag
+
+			return cli.
+				New(double.NewFake(
+					double.FakeWithPersistentFlags(func() []cli.Flag {
+						return []cli.Flag{cli.NewBuiltinFlag("port", "p", port, "", cli.WithEnv("CLI_ASSERT_UNSET_PORT", "CLI_ASSERT_PORT"))}
+					}),
+				)).
+				AddCommand("sub", double.NewFake(
+					double.FakeWithFlags(func() []cli.Flag {
+						flags = []cli.Flag{cli.NewBuiltinFlag("name", "", name, "", cli.WithEnv("CLI_ASSERT_NAME"), cli.WithRequired())}
+						return flags
+					}),
+					double.FakeWithHook(func() *cli.Hook {
+						return &cli.Hook{BeforeCommandExecution: func(context.Context) error {
+							*nameSourceInHook = cli.GetFlagSource(flags[0])
+							return nil
+						}}
+					}),
+				))
+		}
+
+		t.Run("flags not provided are set", func(t *testing.T) {
+			var (
+				port         int
+				name, source string
+			)
+
+			err := executeFunc(t, []string{"app", "sub"}, newCLI(&port, &name, &source))
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, port == 8080 && name == "john", "%d %s", port, name)
+			test.Assert(t, source == "$CLI_ASSERT_NAME", source)
+		})
+
+		t.Run("command line takes precedence", func(t *testing.T) {
+			var (
+				port         int
+				name, source string
+			)
+
+			err := executeFunc(t, []string{"app", "sub", "--name", "jane", "-p", "443"}, newCLI(&port, &name, &source))
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, port == 443 && name == "jane", "%d %s", port, name)
+			test.Assert(t, source == cli.FlagSourceCommandLine, source)
+		})
+
+		t.Run("invalid values", func(t *testing.T) {
+			t.Setenv("CLI_ASSERT_PORT", "invalid")
+
+			var (
+				port         int
+				name, source string
+			)
+
+			spy, spied := double.SpyCLI(newCLI(&port, &name, &source))
+
+			err := executeFunc(t, []string{"app", "sub"}, spied)
+			test.Require(t, err != nil)
+			test.Assert(t, strings.Contains(err.Error(), "environment variable CLI_ASSERT_PORT of flag --port: strconv.ParseInt"), "%v", err)
+			test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "sub"}, "Execute") == 0)
+		})
+	})
+
+	t.Run("completion requests are answered", func(t *testing.T) {
+		type completion struct {
+			args       []string
+			toComplete string
+		}
+
+		newCLI := func(argsCompletion, flagCompletion *completion) *cli.CLI {
+			var (
+				port    int
+				cluster string
+			)
+
+			return cli.
+				New(double.NewFake()).
+				AddCommand("sub", double.NewFake(
+					double.FakeWithFlags(func() []cli.Flag {
+						return []cli.Flag{
+							cli.NewBuiltinFlag("port", "p", &port, ""),
+							cli.NewBuiltinFlag("cluster", "", &cluster, "", cli.WithCompletion(func(_ context.Context, toComplete string) ([]string, error) {
+								*flagCompletion = completion{toComplete: toComplete}
+								return []string{"prod"}, nil
+							})),
+						}
+					}),
+					double.FakeWithComplete(func(_ context.Context, args []string, toComplete string) ([]string, error) {
+						*argsCompletion = completion{args: args, toComplete: toComplete}
+						return []string{"foo"}, nil
+					}),
+				))
+		}
+
+		for name, tt := range map[string]struct {
+			args                   []string
+			expectedArgsCompletion completion
+			expectedFlagCompletion completion
+		}{
+			"arguments": {
+				args:                   []string{"app", "__complete", "sub", "--port", "8080", "bar", "f"},
+				expectedArgsCompletion: completion{args: []string{"bar"}, toComplete: "f"},
+			},
+			"flag values": {
+				args:                   []string{"app", "__complete", "sub", "bar", "--cluster", "pr"},
+				expectedFlagCompletion: completion{toComplete: "pr"},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var argsCompletion, flagCompletion completion
+
+				spy, spied := double.SpyCLI(newCLI(&argsCompletion, &flagCompletion))
+
+				err := executeFunc(t, tt.args, spied)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, slices.Equal(argsCompletion.args, tt.expectedArgsCompletion.args), "%v", argsCompletion.args)
+				test.Assert(t, argsCompletion.toComplete == tt.expectedArgsCompletion.toComplete, argsCompletion.toComplete)
+				test.Assert(t, flagCompletion.toComplete == tt.expectedFlagCompletion.toComplete, flagCompletion.toComplete)
+				test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "sub"}, "Execute") == 0)
+			})
+		}
+	})
+
+	t.Run("flag groups are enforced", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			args          []string
+			expectedError string
+		}{
+			"constraints are respected": {
+				args: []string{"app", "sub", "--file", "f", "--user", "u", "--password", "p"},
+			},
+			"mutually exclusive flags are both set": {
+				args:          []string{"app", "sub", "--file", "f", "--stdin"},
+				expectedError: "flags --file, --stdin are mutually exclusive",
+			},
+			"one of the required flags is missing": {
+				args:          []string{"app", "sub"},
+				expectedError: "at least one of flags --file, --stdin is required",
+			},
+			"flags required together are partially set": {
+				args:          []string{"app", "sub", "--stdin", "--user", "u"},
+				expectedError: "flags --user, --password are required together: missing --password",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					file, user, password string
+					stdin                bool
+				)
+
+				spy, spied := double.SpyCLI(cli.
+					New(double.NewFake(
+						double.FakeWithPersistentFlags(func() []cli.Flag {
+							return cli.RequiredTogetherFlags(
+								cli.NewBuiltinFlag("user", "", &user, ""),
+								cli.NewBuiltinFlag("password", "", &password, ""),
+							)
+						}),
+					)).
+					AddCommand("sub", double.NewFake(
+						double.FakeWithFlags(func() []cli.Flag {
+							return cli.OneRequiredFlags(cli.MutuallyExclusiveFlags(
+								cli.NewBuiltinFlag("file", "", &file, ""),
+								cli.NewBuiltinFlag("stdin", "", &stdin, ""),
+							)...)
+						}),
+					)))
+
+				err := executeFunc(t, tt.args, spied)
+
+				if tt.expectedError == "" {
+					test.Require(t, err == nil, "%v", err)
+					spy.AssertCommandMethodCalled(t, []string{spied.Name, "sub"}, "Execute", true)
+
+					return
+				}
+
+				test.Require(t, err != nil)
+				test.Assert(t, strings.Contains(err.Error(), tt.expectedError), "%v", err)
+
+				var helpErr cli.ShowHelpError
+				test.Assert(t, errors.As(err, &helpErr) && helpErr.ShowHelp())
+				test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "sub"}, "Execute") == 0)
+			})
+		}
+	})
+
+	t.Run("hidden and deprecated flags and commands", func(t *testing.T) {
+		newCLI := func(output *closableBuilder, legacy, name *string) *cli.CLI {
+			return cli.
+				New(double.NewFake(
+					double.FakeWithContext(func(ctx context.Context) context.Context {
+						ctx = cli.NewContextWithMetadata(ctx)
+						cli.SetExitLoggerInMetadata(ctx, output)
+						return ctx
+					}),
+					double.FakeWithPersistentFlags(func() []cli.Flag {
+						return []cli.Flag{cli.NewBuiltinFlag("legacy", "", legacy, "", cli.WithDeprecated("use --name instead"))}
+					}),
+				)).
+				AddCommand("new", double.NewFake(
+					double.FakeWithFlags(func() []cli.Flag {
+						return []cli.Flag{cli.NewBuiltinFlag("name", "", name, "", cli.WithHidden())}
+					}),
+				)).
+				AddCommand("old", double.NewFake(), cli.WithDeprecatedCommand(`use "new" instead`)).
+				AddCommand("secret", double.NewFake(), cli.WithHiddenCommand()).
+				AddCommand("legacy", double.NewFake(double.FakeWithDeprecation(func() string { return `use "new" instead` }))).
+				AddCommand("internal", double.NewFake(double.FakeWithHidden(func() bool { return true })))
+		}
+
+		for name, tc := range map[string]struct {
+			args             []string
+			expectedWarnings string
+		}{
+			"nothing deprecated is used": {
+				args: []string{"app", "new", "--name", "n"},
+			},
+			"hidden command is usable": {
+				args: []string{"app", "secret"},
+			},
+			"command implementing cli.CommandHidden is usable": {
+				args: []string{"app", "internal"},
+			},
+			"deprecated flag is used": {
+				args:             []string{"app", "new", "--legacy", "l"},
+				expectedWarnings: "Flag \"--legacy\" is deprecated, use --name instead\n",
+			},
+			"deprecated command is used": {
+				args:             []string{"app", "old", "--legacy", "l"},
+				expectedWarnings: "Command \"old\" is deprecated, use \"new\" instead\nFlag \"--legacy\" is deprecated, use --name instead\n",
+			},
+			"command implementing cli.CommandDeprecated is used": {
+				args:             []string{"app", "legacy"},
+				expectedWarnings: "Command \"legacy\" is deprecated, use \"new\" instead\n",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					output       closableBuilder
+					legacy, name string
+				)
+
+				err := executeFunc(t, tc.args, newCLI(&output, &legacy, &name))
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, output.String() == tc.expectedWarnings, output.String())
+			})
+		}
+	})
+
+	t.Run("flag aliases are accepted", func(t *testing.T) {
+		newCLI := func(output *closableBuilder, level *string) *cli.CLI {
+			return cli.
+				New(double.NewFake(
+					double.FakeWithContext(func(ctx context.Context) context.Context {
+						ctx = cli.NewContextWithMetadata(ctx)
+						cli.SetExitLoggerInMetadata(ctx, output)
+						return ctx
+					}),
+					double.FakeWithPersistentFlags(func() []cli.Flag {
+						return []cli.Flag{
+							cli.NewBuiltinFlag("log-level", "l", level, "",
+								cli.WithAliases("level"), cli.WithDeprecatedAliases("log-lvl"), cli.WithUnderscoreAliases(),
+							),
+							cli.NewBuiltinFlag("format", "", new(string), "",
+								cli.WithDeprecated("use --log-level instead"), cli.WithAliases("fmt"), cli.WithDeprecatedAliases("frmt"),
+							),
+						}
+					}),
+				)).
+				AddCommand("sub", double.NewFake())
+		}
+
+		for name, tc := range map[string]struct {
+			args             []string
+			expectedWarnings string
+		}{
+			"flag name":                  {args: []string{"app", "--log-level", "debug"}},
+			"alias":                      {args: []string{"app", "--level=debug"}},
+			"underscore spelling":        {args: []string{"app", "--log_level", "debug"}},
+			"inherited alias":            {args: []string{"app", "sub", "--level", "debug"}},
+			"deprecated alias":           {args: []string{"app", "--log-lvl", "debug"}, expectedWarnings: "Flag \"--log-lvl\" is deprecated, use --log-level instead\n"},
+			"inherited deprecated alias": {args: []string{"app", "sub", "--log_lvl", "debug"}, expectedWarnings: "Flag \"--log_lvl\" is deprecated, use --log-level instead\n"},
+			"deprecated flag": {
+				args:             []string{"app", "-l", "debug", "--format", "json"},
+				expectedWarnings: "Flag \"--format\" is deprecated, use --log-level instead\n",
+			},
+			"alias of deprecated flag": {
+				args:             []string{"app", "-l", "debug", "--fmt", "json"},
+				expectedWarnings: "Flag \"--fmt\" is deprecated, use --log-level instead\n",
+			},
+			"deprecated alias of deprecated flag": {
+				args:             []string{"app", "sub", "-l", "debug", "--frmt", "json"},
+				expectedWarnings: "Flag \"--frmt\" is deprecated, use --format instead\n",
+			},
+			"deprecated flag and its deprecated alias": {
+				args:             []string{"app", "-l", "debug", "--frmt", "json", "--format", "yaml"},
+				expectedWarnings: "Flag \"--format\" is deprecated, use --log-level instead\nFlag \"--frmt\" is deprecated, use --format instead\n",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					output closableBuilder
+					level  string
+				)
+
+				err := executeFunc(t, tc.args, newCLI(&output, &level))
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, level == "debug", level)
+				test.Assert(t, output.String() == tc.expectedWarnings, output.String())
+			})
+		}
+
+		t.Run("colliding names are rejected", func(t *testing.T) {
+			var a, b string
+
+			err := executeFunc(t, []string{"app"}, cli.New(double.NewFake(
+				double.FakeWithFlags(func() []cli.Flag {
+					return []cli.Flag{cli.NewBuiltinFlag("a", "", &a, "", cli.WithAliases("b"))}
+				}),
+				double.FakeWithPersistentFlags(func() []cli.Flag {
+					return []cli.Flag{cli.NewBuiltinFlag("b", "", &b, "")}
+				}),
+			)))
+			test.Assert(t, err != nil && strings.Contains(err.Error(), "flag --b redefined"), "%v", err)
+		})
+	})
+
+	t.Run("positional and dashed arguments are split", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			args               []string
+			expectedArgs       []string
+			expectedDashedArgs []string
+		}{
+			"no arguments": {
+				args: []string{"app", "sub"},
+			},
+			"only positional arguments": {
+				args:         []string{"app", "sub", "a", "b"},
+				expectedArgs: []string{"a", "b"},
+			},
+			"only dashed arguments": {
+				args:               []string{"app", "sub", "--", "a", "b"},
+				expectedDashedArgs: []string{"a", "b"},
+			},
+			"positional and dashed arguments": {
+				args:               []string{"app", "sub", "a", "--str", "value", "--", "b", "--c"},
+				expectedArgs:       []string{"a"},
+				expectedDashedArgs: []string{"b", "--c"},
+			},
+			"dashes used as flag value": {
+				args:         []string{"app", "sub", "--str", "--", "a"},
+				expectedArgs: []string{"a"},
+			},
+			"dashes used as short flag value": {
+				args:               []string{"app", "sub", "-s", "--", "a", "--", "b"},
+				expectedArgs:       []string{"a"},
+				expectedDashedArgs: []string{"b"},
+			},
+			"dashes used as inherited flag value before the command": {
+				args:               []string{"app", "--root", "--", "sub", "a", "--", "b"},
+				expectedArgs:       []string{"a"},
+				expectedDashedArgs: []string{"b"},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					flagStr, flagRoot            string
+					capturedArgs, capturedDashed []string
+				)
+
+				err := executeFunc(t, tt.args, cli.
+					New(double.NewFake(double.FakeWithPersistentFlags(func() []cli.Flag {
+						return []cli.Flag{cli.NewBuiltinFlag("root", "", &flagRoot, "Root string flag")}
+					}))).
+					AddCommand("sub", double.NewFake(
+						double.FakeWithFlags(func() []cli.Flag {
+							return []cli.Flag{cli.NewBuiltinFlag("str", "s", &flagStr, "String flag")}
+						}),
+						double.FakeWithExecute(func(_ context.Context, args, dashedArgs []string) error {
+							capturedArgs, capturedDashed = args, dashedArgs
+							return nil
+						}),
+					)),
+				)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, slices.Equal(capturedArgs, tt.expectedArgs), "expected args %v, got %v", tt.expectedArgs, capturedArgs)
+				test.Assert(t, slices.Equal(capturedDashed, tt.expectedDashedArgs), "expected dashed args %v, got %v", tt.expectedDashedArgs, capturedDashed)
+			})
+		}
+	})
+
+	t.Run("arguments are validated against their specification", func(t *testing.T) {
+		newCLI := func(count *int, hooksCalled *[]string) *cli.CLI {
+			return cli.
+				New(double.NewFake(double.FakeWithPersistentHook(func() *cli.PersistentHook {
+					return &cli.PersistentHook{
+						BeforeCommandExecution: func(context.Context) error {
+							*hooksCalled = append(*hooksCalled, "root:PersistentBeforeCommandExecution")
+							return nil
+						},
+						AfterCommandExecution: func(context.Context) error {
+							*hooksCalled = append(*hooksCalled, "root:PersistentAfterCommandExecution")
+							return nil
+						},
+					}
+				}))).
+				AddCommand("sub", double.NewFake(
+					double.FakeWithArgs(func() *cli.ArgsSpec {
+						return &cli.ArgsSpec{
+							Positional: &cli.ArgList{Args: []cli.Arg{
+								cli.NewArg("count", count, "a number"),
+							}},
+							Dashed: &cli.ArgList{Variadic: cli.NewArgWithValuer("rest", nil, ""), MaxVariadic: 1},
+						}
+					}),
+					double.FakeWithHook(func() *cli.Hook {
+						return &cli.Hook{BeforeCommandExecution: func(ctx context.Context) error {
+							*hooksCalled = append(*hooksCalled, "sub:BeforeCommandExecution")
+
+							if args := cli.GetInitializedArgsFromContext(ctx); len(args) != 2 || !args[0].IsSet() {
+								return errors.New("parsed arguments are not available in context")
+							}
+
+							return nil
+						}}
+					}),
+				))
+		}
+
+		t.Run("valid arguments are parsed and bound", func(t *testing.T) {
+			var (
+				count       int
+				hooksCalled []string
+			)
+
+			spy, spied := double.SpyCLI(newCLI(&count, &hooksCalled))
+
+			err := executeFunc(t, []string{"app", "sub", "42", "--", "a"}, spied)
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, count == 42)
+			test.Assert(t, slices.Equal(hooksCalled, []string{
+				"root:PersistentBeforeCommandExecution", "sub:BeforeCommandExecution", "root:PersistentAfterCommandExecution",
+			}), "%v", hooksCalled)
+			spy.AssertCommandMethodCalled(t, []string{spied.Name, "sub"}, "Execute", true)
+		})
+
+		for name, tt := range map[string]struct {
+			args          []string
+			expectedError string
+		}{
+			"missing argument": {
+				args:          []string{"app", "sub"},
+				expectedError: `missing positional argument "count"`,
+			},
+			"invalid argument": {
+				args:          []string{"app", "sub", "abc"},
+				expectedError: `invalid value "abc" for positional argument "count"`,
+			},
+			"unexpected argument": {
+				args:          []string{"app", "sub", "1", "2"},
+				expectedError: `unexpected positional argument "2"`,
+			},
+			"unexpected dashed argument": {
+				args:          []string{"app", "sub", "1", "--", "a", "b"},
+				expectedError: `unexpected dashed argument "b"`,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					count       int
+					hooksCalled []string
+				)
+
+				spy, spied := double.SpyCLI(newCLI(&count, &hooksCalled))
+
+				err := executeFunc(t, tt.args, spied)
+				test.Require(t, err != nil)
+				test.Assert(t, strings.Contains(err.Error(), tt.expectedError), "%v", err)
+
+				var helpErr cli.ShowHelpError
+				test.Assert(t, errors.As(err, &helpErr) && helpErr.ShowHelp())
+				test.Assert(t, len(hooksCalled) == 0, "no hook should run when arguments are invalid: %v", hooksCalled)
+				test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "sub"}, "Execute") == 0)
+			})
+		}
+
+		t.Run("invalid specifications are rejected when commands are built", func(t *testing.T) {
+			var hooksCalled []string
+
+			spy, spied := double.SpyCLI(cli.
+				New(double.NewFake()).
+				AddCommand("sub", double.NewFake(
+					double.FakeWithArgs(func() *cli.ArgsSpec {
+						return &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{cli.NewArgWithValuer("a", nil, "")}, Optional: 2}}
+					}),
+					double.FakeWithHook(func() *cli.Hook {
+						return &cli.Hook{BeforeCommandExecution: func(context.Context) error {
+							hooksCalled = append(hooksCalled, "sub:BeforeCommandExecution")
+							return nil
+						}}
+					}),
+				)))
+
+			// the invalid command is not the executed one, the specification is checked anyway
+			err := executeFunc(t, []string{"app"}, spied)
+			test.Require(t, err != nil)
+			test.Assert(t, strings.Contains(err.Error(), "invalid positional arguments specification: optional arguments count 2 is out of range"), "%v", err)
+
+			var helpErr cli.ShowHelpError
+			test.Assert(t, !errors.As(err, &helpErr) || !helpErr.ShowHelp(), "specification errors are not help errors: %v", err)
+			test.Assert(t, len(hooksCalled) == 0, "no hook should run when the specification is invalid: %v", hooksCalled)
+			test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name}, "Execute") == 0)
+		})
+	})
+
+	t.Run("arguments are reset between executions", func(t *testing.T) {
+		for name, reuseArgs := range map[string]bool{
+			"arguments created on each execution": false,
+			"arguments reused across executions":  true,
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					name  string
+					files []string
+					spec  *cli.ArgsSpec
+				)
+
+				c := cli.New(double.NewFake(double.FakeWithArgs(func() *cli.ArgsSpec {
+					if !reuseArgs || spec == nil {
+						name, files = "default", nil
+						spec = &cli.ArgsSpec{Positional: &cli.ArgList{
+							Args:     []cli.Arg{cli.NewArg("name", &name, "")},
+							Optional: 1,
+							Variadic: cli.NewVariadicArg("files", &files, ""),
+						}}
+					}
+
+					return spec
+				})))
+
+				execute := func(args ...string) error {
+					// the program name is only taken from the arguments of CLIs without name
+					c.Name = ""
+					return executeFunc(t, append([]string{"myapp"}, args...), c)
+				}
+
+				err := execute("john", "a", "b")
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, name == "john" && slices.Equal(files, []string{"a", "b"}), "%s %v", name, files)
+
+				err = execute("jane", "c")
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, name == "jane" && slices.Equal(files, []string{"c"}), "%s %v", name, files)
+
+				err = execute()
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, name == "default" && files == nil, "%s %v", name, files)
+			})
+		}
+	})
+
+	t.Run("help flag shows help without executing the command", func(t *testing.T) {
+		for name, args := range map[string][]string{
+			"long name with missing arguments":  {"app", "sub", "--help"},
+			"short name with missing arguments": {"app", "sub", "-h"},
+			"with other flags and argument":     {"app", "sub", "--name", "x", "a", "--help"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					hookCalled bool
+					flagName   string
+				)
+
+				spy, spied := double.SpyCLI(cli.
+					New(double.NewFake(double.FakeWithPersistentHook(func() *cli.PersistentHook {
+						return &cli.PersistentHook{BeforeCommandExecution: func(context.Context) error {
+							hookCalled = true
+							return nil
+						}}
+					}))).
+					AddCommand("sub", double.NewFake(
+						double.FakeWithArgs(func() *cli.ArgsSpec {
+							return &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{cli.NewArgWithValuer("required", nil, "")}}}
+						}),
+						double.FakeWithFlags(func() []cli.Flag {
+							return []cli.Flag{cli.NewBuiltinFlag("name", "", &flagName, "")}
+						}),
+					)),
+				)
+
+				err := executeFunc(t, args, spied)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, !hookCalled)
+				test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "sub"}, "Execute") == 0)
+			})
+		}
+	})
+
+	t.Run("arguments named help are provided to commands", func(t *testing.T) {
+		for name, args := range map[string][]string{
+			"root command": {"app", "help"},
+			"subcommand":   {"app", "sub", "help"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var (
+					hookCalled   bool
+					executedWith []string
+				)
+
+				cmd := double.NewFake(
+					double.FakeWithArgs(func() *cli.ArgsSpec {
+						return &cli.ArgsSpec{Positional: &cli.ArgList{Variadic: cli.NewArgWithValuer("topic", nil, "")}}
+					}),
+					double.FakeWithHook(func() *cli.Hook {
+						return &cli.Hook{BeforeCommandExecution: func(context.Context) error {
+							hookCalled = true
+							return nil
+						}}
+					}),
+					double.FakeWithExecute(func(_ context.Context, args, _ []string) error {
+						executedWith = args
+						return nil
+					}),
+				)
+
+				c := cli.New(cmd)
+				if len(args) > 2 {
+					c = cli.New(double.NewFake()).AddCommand("sub", cmd)
+				}
+
+				err := executeFunc(t, args, c)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, hookCalled)
+				test.Assert(t, slices.Equal(executedWith, []string{"help"}), "%v", executedWith)
+			})
+		}
+	})
+
+	t.Run("commands can be invoked by their aliases", func(t *testing.T) {
+		for _, invokedAs := range []string{"remove", "rm", "delete"} {
+			t.Run(invokedAs, func(t *testing.T) {
+				spy, spied := double.SpyCLI(cli.
+					New(double.NewFake()).
+					AddCommand("list", double.NewFake()).
+					AddCommand("remove", double.NewFake(
+						double.FakeWithAliases(func() []string { return []string{"rm", "delete"} }),
+					)),
+				)
+
+				err := executeFunc(t, []string{"app", invokedAs}, spied)
+				test.Require(t, err == nil, "%v", err)
+				spy.AssertCommandMethodCalled(t, []string{spied.Name, "remove"}, "Execute", true)
+				test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "list"}, "Execute") == 0)
+				test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name}, "Execute") == 0)
+			})
+		}
+
+		t.Run("aliases colliding with sibling names are rejected", func(t *testing.T) {
+			spy, spied := double.SpyCLI(cli.
+				New(double.NewFake()).
+				AddCommand("rm", double.NewFake()).
+				AddCommand("remove", double.NewFake(
+					double.FakeWithAliases(func() []string { return []string{"rm"} }),
+				)),
+			)
+
+			err := executeFunc(t, []string{"app", "rm"}, spied)
+			test.Assert(t, err != nil)
+			test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "rm"}, "Execute") == 0)
+			test.Assert(t, spy.CountCommandMethodCalls([]string{spied.Name, "remove"}, "Execute") == 0)
+		})
+	})
+
 	t.Run("persistent flags are inherited by subcommands", func(t *testing.T) {
 		var (
 			rootFlag   string
@@ -374,3 +1433,8 @@ func AssertImplementation(t *testing.T, executeFunc func(*testing.T, []string, *
 		})
 	})
 }
+
+// closableBuilder is a strings.Builder implementing io.Closer, to be used as exit logger.
+type closableBuilder struct{ strings.Builder }
+
+func (*closableBuilder) Close() error { return nil }
@@ -0,0 +1,253 @@
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
+)
+
+// command is a node of the command tree built from a `cli.CLI`.
+// It holds everything needed to parse arguments and run hooks without calling the
+// `cli.Command` interfaces methods more than once.
+type command struct {
+	ctx         context.Context
+	cli         *cli.CLI
+	name        string
+	aliases     []string
+	parent      *command
+	subCommands []*command
+
+	cmd            cli.Command
+	hook           *cli.Hook
+	persistentHook *cli.PersistentHook
+
+	args            *cli.ArgsSpec
+	localFlags      []cli.Flag
+	persistentFlags []cli.Flag
+
+	flagsFromEnv     func() error
+	checkFlags       func(context.Context) error
+	warnDeprecations func(context.Context)
+}
+
+// buildCommandFromCLIRecursively constructs a command from a `cli.CLI` instance.
+// It recursively processes subcommands, creating a tree of commands that mirrors the
+// structure of the `cli.CLI`.
+func buildCommandFromCLIRecursively(ctx context.Context, parent *command, c *cli.CLI) (*command, error) {
+	ctx = cli.NewCommandContext(ctx)
+	ctx = mapper.Context(c.Command, ctx)
+
+	cmd, err := buildCommandFromCLICommand(ctx, parent, c)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build command %s: %w", c.Name, err)
+	}
+
+	if err := mapper.CheckSubCommandsNames(c); err != nil {
+		return nil, fmt.Errorf("unable to build command %s: %w", c.Name, err)
+	}
+
+	for _, subCommand := range c.SubCommands {
+		sub, err := buildCommandFromCLIRecursively(ctx, cmd, subCommand)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build sub-command %s of command %s: %w", subCommand.Name, c.Name, err)
+		}
+
+		cmd.subCommands = append(cmd.subCommands, sub)
+	}
+
+	return cmd, nil
+}
+
+// buildCommandFromCLICommand creates a single command from the `cli.Command` of the provided `cli.CLI`.
+func buildCommandFromCLICommand(ctx context.Context, parent *command, c *cli.CLI) (*command, error) {
+	cliCommand := c.Command
+
+	cmd := &command{
+		ctx:            ctx,
+		cli:            c,
+		name:           c.Name,
+		aliases:        mapper.Aliases(cliCommand),
+		parent:         parent,
+		cmd:            cliCommand,
+		hook:           mapper.Hook(cliCommand),
+		persistentHook: mapper.PersistentHook(cliCommand),
+		args:           mapper.Args(cliCommand),
+	}
+
+	if err := cmd.persistentHook.BeforeFlagsDefinition(ctx); err != nil {
+		return nil, fmt.Errorf("pre-flag-definition hook failed: %w", err)
+	}
+
+	localFlags, persistentFlags := mapper.Flags(cliCommand), mapper.PersistentFlags(cliCommand)
+	cli.SetInitializedFlagsInContext(ctx, localFlags, persistentFlags)
+	cli.SetInitializedArgsInContext(ctx, cmd.args)
+
+	// aliases are parsed as flags of their own, which are hidden from help
+	cmd.localFlags, cmd.persistentFlags = mapper.FlagsWithAliases(localFlags), mapper.FlagsWithAliases(persistentFlags)
+
+	// flags may be reused across executions, values of a previous execution must not leak
+	mapper.ResetFlags(slices.Concat(cmd.localFlags, cmd.persistentFlags))
+
+	if _, err := newFlagSet(append(slices.Clone(cmd.localFlags), cmd.persistentFlags...)); err != nil {
+		return nil, err
+	}
+
+	if err := mapper.CheckArgs(cmd.args); err != nil {
+		return nil, err
+	}
+
+	flags := slices.Concat(cmd.localFlags, cmd.persistentFlags, cmd.inheritedFlags())
+	cmd.flagsFromEnv = mapper.NewFlagsFromEnv(flags)
+	cmd.checkFlags = mapper.NewFlagsCheck(flags)
+	cmd.warnDeprecations = mapper.NewDeprecationWarnings(c, flags)
+
+	return cmd, nil
+}
+
+// chain returns the commands from the root to this command.
+func (c *command) chain() []*command {
+	var chain []*command
+	for cmd := c; cmd != nil; cmd = cmd.parent {
+		chain = append(chain, cmd)
+	}
+
+	slices.Reverse(chain)
+
+	return chain
+}
+
+// subCommand returns the direct subcommand with the provided name or alias, if any.
+func (c *command) subCommand(name string) *command {
+	for _, sub := range c.subCommands {
+		if sub.name == name || slices.Contains(sub.aliases, name) {
+			return sub
+		}
+	}
+
+	return nil
+}
+
+// inheritedFlags returns the persistent flags of all parent commands, the nearest parent first.
+func (c *command) inheritedFlags() []cli.Flag {
+	var flags []cli.Flag
+	for parent := c.parent; parent != nil; parent = parent.parent {
+		flags = append(flags, parent.persistentFlags...)
+	}
+
+	return flags
+}
+
+// flagSet returns the set of flags usable by the command: its local and persistent flags,
+// and the persistent flags of its parents. Flags defined by the command shadow the parents ones.
+func (c *command) flagSet() *flagSet {
+	set, _ := newFlagSet(append(slices.Clone(c.localFlags), c.persistentFlags...)) //nolint:errcheck // collisions are checked while building the command
+	for _, flag := range c.inheritedFlags() {
+		set.addIfAvailable(flag)
+	}
+
+	return set
+}
+
+// find walks the command tree following the subcommand names found in args.
+// It returns the deepest matching command and the arguments without the subcommand names.
+func (c *command) find(args []string) (*command, []string) {
+	set := c.flagSet()
+
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; {
+		case arg == "--":
+			return c, args
+		case isFlag(arg):
+			if set.expectsValueInNextArg(arg) {
+				i++
+			}
+		default:
+			sub := c.subCommand(arg)
+			if sub == nil {
+				return c, args
+			}
+
+			return sub.find(slices.Delete(slices.Clone(args), i, i+1))
+		}
+	}
+
+	return c, args
+}
+
+// execute finds the command to execute from the arguments, parses its flags and arguments,
+// and executes it along with its hooks. Flags not provided are set from their environment variables
+// right before the command hook. Persistent hooks are executed parent first before
+// the command execution, and child first after the command execution. Flags requirements and
+// groups are checked once the hooks ran, right before the command execution, along with the
+// deprecation warnings.
+func (c *command) execute(w io.Writer, args []string) error {
+	cmd, args := c.find(args)
+
+	args, dashedArgs, err := cmd.flagSet().parse(args)
+	if err != nil {
+		if errors.Is(err, errHelpRequested) {
+			return writeHelp(w, cmd)
+		}
+
+		return err
+	}
+
+	if err := mapper.ParseArgs(cmd.args, args, dashedArgs); err != nil {
+		return writeHelpOnHelpError(w, cmd, err)
+	}
+
+	chain := cmd.chain()
+
+	for _, parent := range chain {
+		if err := parent.persistentHook.BeforeCommandExecution(parent.ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := cmd.flagsFromEnv(); err != nil {
+		return err
+	}
+
+	if err := cmd.hook.BeforeCommandExecution(cmd.ctx); err != nil {
+		return err
+	}
+
+	cmd.warnDeprecations(cmd.ctx)
+
+	err = cmd.checkFlags(cmd.ctx)
+	if err == nil {
+		err = cmd.cmd.Execute(cmd.ctx, args, dashedArgs)
+	}
+
+	err = writeHelpOnHelpError(w, cmd, err)
+
+	errs := []error{err, cmd.hook.AfterCommandExecution(cmd.ctx)}
+	for _, parent := range slices.Backward(chain) {
+		errs = append(errs, parent.persistentHook.AfterCommandExecution(parent.ctx))
+	}
+
+	return errors.Join(errs...)
+}
+
+// writeHelpOnHelpError writes the command's help if the provided error is a `ShowHelpError` requesting it.
+func writeHelpOnHelpError(w io.Writer, c *command, err error) error {
+	var showHelpErr cli.ShowHelpError
+	if errors.As(err, &showHelpErr) {
+		if showHelpErr.ShowHelp() {
+			err = errors.Join(err, writeHelp(w, c))
+		}
+	}
+
+	return err
+}
+
+// isFlag returns whether the provided argument looks like a flag.
+func isFlag(arg string) bool {
+	return len(arg) > 1 && strings.HasPrefix(arg, "-")
+}
@@ -0,0 +1,22 @@
+package native
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+)
+
+func Test_dependencies(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	output, err := exec.CommandContext(t.Context(), "go", "list", "-deps", "-f", "{{if not .Standard}}{{.ImportPath}}{{end}}", ".").Output()
+	test.Require(t, err == nil, "%v", err)
+
+	for dependency := range strings.FieldsSeq(string(output)) {
+		test.Assert(t, strings.HasPrefix(dependency, "github.com/krostar/cli"), "native mapper depends on %s", dependency)
+	}
+}
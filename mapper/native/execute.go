@@ -0,0 +1,69 @@
+// Package native provides a dependency-free CLI mapper built on the standard library.
+//
+// It parses the command tree itself, supporting GNU-style long and short flags,
+// bundled short boolean flags, persistent flags inheritance, dashed arguments
+// and help output, without relying on any third party CLI framework.
+package native
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
+)
+
+// Execute executes the CLI with the native backend.
+// It builds a command tree from the provided cli.CLI instance,
+// applies any provided options, and executes the command matching the arguments.
+//
+// Completion requests made by the scripts of the completion package are answered
+// instead of executing a command.
+//
+// Note: The first argument in args (if present) is used as the CLI name and
+// removed from the argument list passed to the actual command.
+func Execute(ctx context.Context, args []string, c *cli.CLI, opts ...Option) error {
+	// set CLI name from the first argument (typically the binary name)
+	// and remove it from the arguments passed to the command
+	if c.Name == "" && len(args) > 0 {
+		c.Name = args[0]
+		args = args[1:]
+	}
+
+	o := options{writer: os.Stdout}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if mapper.CompletionRequested(args) {
+		return mapper.WriteCompletion(ctx, o.writer, c, args[1:])
+	}
+
+	command, err := buildCommandFromCLIRecursively(ctx, nil, c)
+	if err != nil {
+		return fmt.Errorf("unable to build command from cli: %w", err)
+	}
+
+	return command.execute(o.writer, args)
+}
+
+type options struct {
+	writer io.Writer
+}
+
+// Option is a function type for configuring the execution.
+// This allows for customizing various aspects of the command behavior.
+type Option func(o *options)
+
+// WithWriter returns an Option that sets the writer used to display help and completion candidates.
+// By default, they are written on the standard output.
+//
+// Unlike other mappers, no ForTest option is provided as it would pull test
+// dependencies in the binaries; tests can provide their own writer instead.
+func WithWriter(writer io.Writer) Option {
+	return func(o *options) {
+		o.writer = writer
+	}
+}
@@ -0,0 +1,140 @@
+package native
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+	"github.com/krostar/cli/internal/mapper/testwriter"
+	"github.com/krostar/cli/mapper"
+)
+
+func Test_Execute(t *testing.T) {
+	t.Run("applies all options", func(t *testing.T) {
+		option1Called, option2Called := false, false
+		option1 := func(*options) { option1Called = true }
+		option2 := func(*options) { option2Called = true }
+
+		err := Execute(t.Context(), []string{"root"}, cli.New(double.NewFake()), option1, option2)
+		test.Assert(t, err == nil, "%v", err)
+		test.Assert(t, option1Called && option2Called)
+	})
+
+	t.Run("cli build failed", func(t *testing.T) {
+		anError := errors.New("boom")
+
+		c := cli.New(double.NewFake(double.FakeWithPersistentHook(func() *cli.PersistentHook {
+			return &cli.PersistentHook{BeforeFlagsDefinition: func(context.Context) error { return anError }}
+		})))
+
+		err := Execute(t.Context(), nil, c, WithWriter(testwriter.New(t)))
+		test.Require(t, err != nil && errors.Is(err, anError), "%v", err)
+		test.Assert(t, strings.Contains(err.Error(), "unable to build command from cli"))
+	})
+
+	t.Run("flags collision", func(t *testing.T) {
+		var a, b string
+
+		c := cli.New(double.NewFake(
+			double.FakeWithFlags(func() []cli.Flag { return []cli.Flag{cli.NewBuiltinFlag("a", "", &a, "")} }),
+			double.FakeWithPersistentFlags(func() []cli.Flag { return []cli.Flag{cli.NewBuiltinFlag("a", "", &b, "")} }),
+		))
+
+		err := Execute(t.Context(), nil, c, WithWriter(testwriter.New(t)))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "flag --a redefined"), "%v", err)
+	})
+
+	t.Run("help flag displays help", func(t *testing.T) {
+		output := new(bytes.Buffer)
+
+		spy, spied := double.SpyCLI(cli.New(double.NewFake(
+			double.FakeWithDescription(func() string { return "root description" }),
+		)).AddCommand("sub", double.NewFake()))
+
+		err := Execute(t.Context(), []string{"app", "sub", "--help"}, spied, WithWriter(output))
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, spy.CountCommandMethodCalls([]string{"app", "sub"}, "Execute") == 0)
+		test.Assert(t, strings.Contains(output.String(), "app sub [flags]"), output.String())
+	})
+
+	t.Run("unknown flag", func(t *testing.T) {
+		err := Execute(t.Context(), []string{"app", "--foo"}, cli.New(double.NewFake()), WithWriter(testwriter.New(t)))
+		test.Assert(t, err != nil && strings.Contains(err.Error(), "unknown flag: --foo"), "%v", err)
+	})
+
+	t.Run("help is rendered by the help package", func(t *testing.T) {
+		output := new(bytes.Buffer)
+
+		var (
+			verbose bool
+			port    int
+			token   string
+		)
+
+		c := cli.New(double.NewFake(
+			double.FakeWithPersistentFlags(func() []cli.Flag {
+				return []cli.Flag{cli.NewBuiltinFlag("verbose", "v", &verbose, "more logs")}
+			}),
+		)).AddCommand("serve", double.NewFake(
+			double.FakeWithDescription(func() string { return "serve things" }),
+			double.FakeWithUsage(func() string { return "<address>" }),
+			double.FakeWithArgs(func() *cli.ArgsSpec {
+				return &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{cli.NewArgWithValuer("address", nil, "where to listen")}}}
+			}),
+			double.FakeWithFlags(func() []cli.Flag {
+				return []cli.Flag{
+					cli.NewBuiltinFlag("port", "p", &port, "listen port"),
+					cli.NewBuiltinFlag("token", "", &token, "auth token", cli.WithHelpGroup("Security")),
+				}
+			}),
+		))
+
+		err := Execute(t.Context(), []string{"app", "serve", "--help"}, c, WithWriter(output))
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, output.String() == `serve things
+
+Usage:
+  app serve [flags] <address>
+
+Arguments:
+  address   where to listen
+
+Flags:
+  -p, --port int   listen port
+  -h, --help       help for serve
+
+Security:
+      --token string   auth token
+
+Global Flags:
+  -v, --verbose   more logs
+`, output.String())
+	})
+
+	t.Run("completion request", func(t *testing.T) {
+		output := new(bytes.Buffer)
+
+		spy, spied := double.SpyCLI(cli.New(double.NewFake()).
+			AddCommand("serve", double.NewFake(double.FakeWithComplete(func(context.Context, []string, string) ([]string, error) {
+				return []string{"foo", "bar"}, nil
+			}))).
+			AddCommand("status", double.NewFake()))
+
+		err := Execute(t.Context(), []string{"app", "__complete", "serve", "f"}, spied, WithWriter(output))
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, output.String() == "foo\n:4\n", output.String())
+		test.Assert(t, spy.CountCommandMethodCalls([]string{"app", "serve"}, "Execute") == 0)
+	})
+
+	t.Run("implementation checks", func(t *testing.T) {
+		mapper.AssertImplementation(t, func(t *testing.T, args []string, c *cli.CLI) error {
+			return Execute(t.Context(), args, c, WithWriter(testwriter.New(t)))
+		})
+	})
+}
@@ -0,0 +1,225 @@
+package native
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
+)
+
+// errHelpRequested is returned while parsing arguments when the help flag is provided.
+var errHelpRequested = errors.New("help requested")
+
+// flagSet indexes flags by their long and short names.
+type flagSet struct {
+	flags []cli.Flag
+	long  map[string]cli.Flag
+	short map[string]cli.Flag
+}
+
+// newFlagSet creates a flag set from the provided flags.
+// It returns an error if two flags share the same long or short name.
+func newFlagSet(flags []cli.Flag) (*flagSet, error) {
+	set := &flagSet{
+		long:  make(map[string]cli.Flag),
+		short: make(map[string]cli.Flag),
+	}
+
+	for _, flag := range flags {
+		if !set.addIfAvailable(flag) {
+			return nil, fmt.Errorf("flag %s redefined", flagRepr(flag))
+		}
+	}
+
+	return set, nil
+}
+
+// addIfAvailable adds the flag to the set only if none of its names are already used.
+// It returns whether the flag has been added.
+func (set *flagSet) addIfAvailable(flag cli.Flag) bool {
+	longName, shortName := flag.LongName(), flag.ShortName()
+
+	if _, exists := set.long[longName]; longName != "" && exists {
+		return false
+	}
+
+	if _, exists := set.short[shortName]; shortName != "" && exists {
+		return false
+	}
+
+	if longName != "" {
+		set.long[longName] = flag
+	}
+
+	if shortName != "" {
+		set.short[shortName] = flag
+	}
+
+	set.flags = append(set.flags, flag)
+
+	return true
+}
+
+// parse parses the raw arguments, setting the value of the provided flags.
+// It supports GNU-style long flags (--flag value, --flag=value), short flags (-f value,
+// -fvalue, -f=value), and bundled short flags (-abc). Arguments found after the first
+// "--" are returned as dashed arguments, the other non-flag arguments as positional arguments.
+func (set *flagSet) parse(args []string) ([]string, []string, error) {
+	var positionalArgs []string
+
+	for i := 0; i < len(args); i++ {
+		var (
+			consumed int
+			err      error
+		)
+
+		switch arg := args[i]; {
+		case arg == "--":
+			var dashedArgs []string
+			if len(args[i+1:]) > 0 {
+				dashedArgs = args[i+1:]
+			}
+
+			return positionalArgs, dashedArgs, nil
+		case strings.HasPrefix(arg, "--"):
+			consumed, err = set.parseLongFlag(arg[2:], args[i+1:])
+		case isFlag(arg):
+			consumed, err = set.parseShortFlags(arg[1:], args[i+1:])
+		default:
+			positionalArgs = append(positionalArgs, arg)
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		i += consumed
+	}
+
+	return positionalArgs, nil, nil
+}
+
+// parseLongFlag parses a long flag, without its leading dashes. If the flag expects
+// a value which is not provided with an equal sign, the next argument is used.
+// It returns the number of next arguments consumed.
+func (set *flagSet) parseLongFlag(arg string, next []string) (int, error) {
+	name, value, hasValue := strings.Cut(arg, "=")
+
+	flag, exists := set.long[name]
+	if !exists {
+		if name == "help" {
+			return 0, errHelpRequested
+		}
+
+		return 0, fmt.Errorf("unknown flag: --%s", name)
+	}
+
+	var consumed int
+
+	switch {
+	case hasValue:
+	case !mapper.FlagExpectsValue(flag):
+		value = flagValueWithoutArgument(flag)
+	case len(next) == 0:
+		return 0, fmt.Errorf("flag needs an argument: --%s", name)
+	default:
+		value, consumed = next[0], 1
+	}
+
+	return consumed, setFlagValue(flag, value)
+}
+
+// parseShortFlags parses one or many bundled short flags, without the leading dash.
+// The last short flag can expect a value, provided right after the flag name, or in the next argument.
+// It returns the number of next arguments consumed.
+func (set *flagSet) parseShortFlags(arg string, next []string) (int, error) {
+	for i, r := range arg {
+		name := string(r)
+
+		flag, exists := set.short[name]
+		if !exists {
+			if name == "h" {
+				return 0, errHelpRequested
+			}
+
+			return 0, fmt.Errorf("unknown shorthand flag: %q in -%s", name, arg)
+		}
+
+		rest := arg[i+utf8.RuneLen(r):]
+
+		switch {
+		case strings.HasPrefix(rest, "="):
+			return 0, setFlagValue(flag, rest[1:])
+		case !mapper.FlagExpectsValue(flag):
+			if err := setFlagValue(flag, flagValueWithoutArgument(flag)); err != nil {
+				return 0, err
+			}
+		case rest != "":
+			return 0, setFlagValue(flag, rest)
+		case len(next) == 0:
+			return 0, fmt.Errorf("flag needs an argument: %q in -%s", name, arg)
+		default:
+			return 1, setFlagValue(flag, next[0])
+		}
+	}
+
+	return 0, nil
+}
+
+// expectsValueInNextArg returns whether the provided flag argument, given it exists in the
+// set, consumes the next argument as its value.
+func (set *flagSet) expectsValueInNextArg(arg string) bool {
+	if name, ok := strings.CutPrefix(arg, "--"); ok {
+		flag, exists := set.long[name]
+		return exists && mapper.FlagExpectsValue(flag)
+	}
+
+	for i, r := range arg[1:] {
+		flag, exists := set.short[string(r)]
+		if !exists {
+			return false
+		}
+
+		if mapper.FlagExpectsValue(flag) {
+			return i+utf8.RuneLen(r) == len(arg[1:])
+		}
+	}
+
+	return false
+}
+
+// flagValueWithoutArgument returns the value to set to a flag that does not expect
+// a value, when it is provided without one.
+func flagValueWithoutArgument(flag cli.Flag) string {
+	if mapper.FlagCounter(flag) {
+		return cli.FlagCounterIncrement
+	}
+
+	return "true"
+}
+
+func setFlagValue(flag cli.Flag, value string) error {
+	if err := flag.FromString(value); err != nil {
+		return fmt.Errorf("invalid argument %q for %s flag: %w", value, flagRepr(flag), err)
+	}
+
+	return nil
+}
+
+// flagRepr returns a human representation of the flag names, like "-s, --long".
+func flagRepr(flag cli.Flag) string {
+	var names []string
+
+	if shortName := flag.ShortName(); shortName != "" {
+		names = append(names, "-"+shortName)
+	}
+
+	if longName := flag.LongName(); longName != "" {
+		names = append(names, "--"+longName)
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,135 @@
+package native
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+)
+
+func Test_flagSet_parse(t *testing.T) {
+	type flagValues struct {
+		a, b, c bool
+		str     string
+		i       int
+	}
+
+	newSet := func(t *testing.T, values *flagValues) *flagSet {
+		set, err := newFlagSet([]cli.Flag{
+			cli.NewBuiltinFlag("all", "a", &values.a, ""),
+			cli.NewBuiltinFlag("bee", "b", &values.b, ""),
+			cli.NewBuiltinFlag("", "c", &values.c, ""),
+			cli.NewBuiltinFlag("str", "s", &values.str, ""),
+			cli.NewBuiltinFlag("int", "", &values.i, ""),
+		})
+		test.Require(t, err == nil, "%v", err)
+
+		return set
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			args               []string
+			expectedValues     flagValues
+			expectedArgs       []string
+			expectedDashedArgs []string
+		}{
+			"long flags": {
+				args:           []string{"--all", "--str", "value", "--int=42", "--bee=false"},
+				expectedValues: flagValues{a: true, str: "value", i: 42},
+			},
+			"short flags": {
+				args:           []string{"-a", "-s", "value", "-c"},
+				expectedValues: flagValues{a: true, c: true, str: "value"},
+			},
+			"bundled short flags": {
+				args:           []string{"-abc"},
+				expectedValues: flagValues{a: true, b: true, c: true},
+			},
+			"bundled short flags with value": {
+				args:           []string{"-acsvalue"},
+				expectedValues: flagValues{a: true, c: true, str: "value"},
+			},
+			"bundled short flags with value in next argument": {
+				args:           []string{"-as", "value"},
+				expectedValues: flagValues{a: true, str: "value"},
+			},
+			"short flag with equal": {
+				args:           []string{"-s=value", "-a=false"},
+				expectedValues: flagValues{str: "value"},
+			},
+			"positional and dashed arguments": {
+				args:               []string{"foo", "-a", "-", "bar", "--", "-b", "--str"},
+				expectedValues:     flagValues{a: true},
+				expectedArgs:       []string{"foo", "-", "bar"},
+				expectedDashedArgs: []string{"-b", "--str"},
+			},
+			"empty dashed arguments": {
+				args:         []string{"foo", "--"},
+				expectedArgs: []string{"foo"},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var values flagValues
+
+				args, dashedArgs, err := newSet(t, &values).parse(tt.args)
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, values == tt.expectedValues, "expected %+v, got %+v", tt.expectedValues, values)
+				test.Assert(t, slices.Equal(args, tt.expectedArgs), "expected args %v, got %v", tt.expectedArgs, args)
+				test.Assert(t, slices.Equal(dashedArgs, tt.expectedDashedArgs), "expected dashed args %v, got %v", tt.expectedDashedArgs, dashedArgs)
+			})
+		}
+	})
+
+	t.Run("ko", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			args          []string
+			errorContains string
+		}{
+			"unknown long flag":         {args: []string{"--foo"}, errorContains: "unknown flag: --foo"},
+			"unknown short flag":        {args: []string{"-az"}, errorContains: `unknown shorthand flag: "z" in -az`},
+			"missing long flag value":   {args: []string{"--str"}, errorContains: "flag needs an argument: --str"},
+			"missing short flag value":  {args: []string{"-as"}, errorContains: `flag needs an argument: "s" in -as`},
+			"invalid long flag value":   {args: []string{"--int", "abc"}, errorContains: `invalid argument "abc" for --int flag`},
+			"invalid bundled flag bool": {args: []string{"-a=abc"}, errorContains: `invalid argument "abc" for -a, --all flag`},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := newSet(t, new(flagValues)).parse(tt.args)
+				test.Assert(t, err != nil && strings.Contains(err.Error(), tt.errorContains), "%v", err)
+			})
+		}
+	})
+
+	t.Run("help", func(t *testing.T) {
+		for _, args := range [][]string{{"--help"}, {"-h"}, {"-ah"}} {
+			_, _, err := newSet(t, new(flagValues)).parse(args)
+			test.Assert(t, errors.Is(err, errHelpRequested), "%v: %v", args, err)
+		}
+	})
+}
+
+func Test_flagSet_expectsValueInNextArg(t *testing.T) {
+	var (
+		b bool
+		s string
+	)
+
+	set, err := newFlagSet([]cli.Flag{
+		cli.NewBuiltinFlag("bool", "b", &b, ""),
+		cli.NewBuiltinFlag("str", "s", &s, ""),
+	})
+	test.Require(t, err == nil, "%v", err)
+
+	test.Assert(t, set.expectsValueInNextArg("--str"))
+	test.Assert(t, set.expectsValueInNextArg("-s"))
+	test.Assert(t, set.expectsValueInNextArg("-bs"))
+	test.Assert(t, !set.expectsValueInNextArg("--str=value"))
+	test.Assert(t, !set.expectsValueInNextArg("-svalue"))
+	test.Assert(t, !set.expectsValueInNextArg("--bool"))
+	test.Assert(t, !set.expectsValueInNextArg("-b"))
+	test.Assert(t, !set.expectsValueInNextArg("--unknown"))
+}
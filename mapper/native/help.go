@@ -0,0 +1,24 @@
+package native
+
+import (
+	"io"
+	"slices"
+
+	clihelp "github.com/krostar/cli/help"
+)
+
+// writeHelp writes the help of the provided command with the help package: its description, usage, arguments,
+// aliases, examples, subcommands, and the flags it can use (including the inherited ones).
+func writeHelp(w io.Writer, c *command) error {
+	var path []string
+	for _, cmd := range c.chain() {
+		path = append(path, cmd.name)
+	}
+
+	return clihelp.Write(c.ctx, w, clihelp.Command{
+		Path:           path,
+		CLI:            c.cli,
+		Flags:          slices.Concat(c.localFlags, c.persistentFlags),
+		InheritedFlags: c.inheritedFlags(),
+	})
+}
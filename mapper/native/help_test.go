@@ -0,0 +1,99 @@
+package native
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/krostar/test"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+)
+
+func Test_writeHelp(t *testing.T) {
+	var (
+		verbose bool
+		name    string
+		count   = 1
+		format  = "text"
+		legacy  string
+	)
+
+	root, err := buildCommandFromCLIRecursively(context.Background(), nil, cli.
+		New(double.NewFake(
+			double.FakeWithPersistentFlags(func() []cli.Flag {
+				return []cli.Flag{cli.NewBuiltinFlag("verbose", "v", &verbose, "more logs")}
+			}),
+		)).
+		AddCommand("greet", double.NewFake(
+			double.FakeWithDescription(func() string { return "greet someone\nby displaying its name" }),
+			double.FakeWithUsage(func() string { return "<name>" }),
+			double.FakeWithArgs(func() *cli.ArgsSpec {
+				return &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{cli.NewArgWithValuer("name", nil, "who to greet")}}}
+			}),
+			double.FakeWithAliases(func() []string { return []string{"hi", "hello"} }),
+			double.FakeWithExamples(func() []string { return []string{"app greet bob"} }),
+			double.FakeWithFlags(func() []cli.Flag {
+				return []cli.Flag{
+					cli.NewBuiltinFlag("name", "n", &name, "the name", cli.WithRequired()),
+					cli.NewBuiltinFlag("count", "", &count, "how many times"),
+					cli.NewEnumFlag("format", "", &format, []string{"text", "json"}, "output format"),
+					cli.NewBuiltinFlag("debug", "", &verbose, "debug logs", cli.WithHidden()),
+					cli.NewBuiltinFlag("legacy", "", &legacy, "legacy name", cli.WithDeprecated("use --name instead")),
+				}
+			}),
+		)).
+		AddCommand("welcome", double.NewFake(), cli.WithDeprecatedCommand(`use "greet" instead`)).
+		AddCommand("secret", double.NewFake(), cli.WithHiddenCommand()),
+	)
+	test.Require(t, err == nil, "%v", err)
+	root.name = "app"
+
+	t.Run("root", func(t *testing.T) {
+		output := new(bytes.Buffer)
+		test.Require(t, writeHelp(output, root) == nil)
+		test.Assert(t, output.String() == `Usage:
+  app [flags]
+  app [command]
+
+Available Commands:
+  greet   greet someone
+
+Flags:
+  -v, --verbose   more logs
+  -h, --help      help for app
+
+Use "app [command] --help" for more information about a command.
+`, output.String())
+	})
+
+	t.Run("sub", func(t *testing.T) {
+		output := new(bytes.Buffer)
+		test.Require(t, writeHelp(output, root.subCommand("hi")) == nil)
+		test.Assert(t, output.String() == `greet someone
+by displaying its name
+
+Usage:
+  app greet [flags] <name>
+
+Arguments:
+  name   who to greet
+
+Aliases:
+  greet, hi, hello
+
+Examples:
+  app greet bob
+
+Flags:
+  -n, --name string          the name (required)
+      --count int            how many times (default 1)
+      --format {text|json}   output format (default text)
+  -h, --help                 help for greet
+
+Global Flags:
+  -v, --verbose   more logs
+`, output.String())
+	})
+}
@@ -4,28 +4,39 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/krostar/cli"
-	mapper "github.com/krostar/cli/mapper/internal"
+	clihelp "github.com/krostar/cli/help"
+	mapper "github.com/krostar/cli/internal/mapper"
 )
 
 // buildCobraCommandFromCLIRecursively constructs a `cobra.Command` from a `cli.CLI` instance.
 // It recursively processes subcommands, creating a tree of `cobra.Command`s that mirrors the
 // structure of the `cli.CLI`.
-func buildCobraCommandFromCLIRecursively(ctx context.Context, c *cli.CLI) (*cobra.Command, error) {
+// The parents are the names of the parents of the command, and the inheritedFlags their persistent flags, the nearest parent first.
+func buildCobraCommandFromCLIRecursively(ctx context.Context, c *cli.CLI, parents []string, inheritedFlags []cli.Flag) (*cobra.Command, error) {
 	ctx = cli.NewCommandContext(ctx)
 	ctx = mapper.Context(c.Command, ctx)
 
-	command, err := buildCobraCommandFromCLICommand(ctx, c.Name, c.Command)
+	path := append(slices.Clone(parents), c.Name)
+
+	command, persistentFlags, err := buildCobraCommandFromCLICommand(ctx, c, path, inheritedFlags)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build command %s: %w", c.Name, err)
 	}
 
+	if err := mapper.CheckSubCommandsNames(c); err != nil {
+		return nil, fmt.Errorf("unable to build command %s: %w", c.Name, err)
+	}
+
+	inheritedFlags = slices.Concat(persistentFlags, inheritedFlags)
+
 	for _, subCommand := range c.SubCommands {
-		sub, err := buildCobraCommandFromCLIRecursively(ctx, subCommand)
+		sub, err := buildCobraCommandFromCLIRecursively(ctx, subCommand, path, inheritedFlags)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build sub-command %s of command %s: %w", subCommand.Name, c.Name, err)
 		}
@@ -36,19 +47,30 @@ func buildCobraCommandFromCLIRecursively(ctx context.Context, c *cli.CLI) (*cobr
 	return command, nil
 }
 
-// buildCobraCommandFromCLICommand creates a single `cobra.Command` from a `cli.Command`.
-func buildCobraCommandFromCLICommand(ctx context.Context, commandName string, cliCommand cli.Command) (*cobra.Command, error) {
+// buildCobraCommandFromCLICommand creates a single `cobra.Command` from the `cli.Command` of the provided `cli.CLI`.
+// It also returns the persistent flags of the command, aliases included, to be inherited by its subcommands.
+// Hidden and deprecated commands are mapped onto the cobra Hidden and Deprecated fields, but the deprecation
+// warning is written by the mapper to the exit logger, with the same format whatever the mapper, see Execute.
+// Help and usage are rendered by the help package.
+func buildCobraCommandFromCLICommand(ctx context.Context, c *cli.CLI, path []string, inheritedFlags []cli.Flag) (*cobra.Command, []cli.Flag, error) {
+	cliCommand := c.Command
+
 	var commandExample string
 	if examples := mapper.Examples(cliCommand); len(examples) > 0 {
 		commandExample = "  " + strings.Join(mapper.Examples(cliCommand), "\n  ")
 	}
 
+	argsSpec := mapper.Args(cliCommand)
+	cli.SetInitializedArgsInContext(ctx, argsSpec)
+
 	cobraCommand := &cobra.Command{
-		Use:     commandName + " " + mapper.Usage(cliCommand),
-		Short:   mapper.ShortDescription(cliCommand),
-		Long:    mapper.Description(cliCommand),
-		Example: commandExample,
-		RunE:    cobraHandlerFromCLIHandler(ctx, cliCommand),
+		Use:        c.Name + " " + mapper.Usage(cliCommand),
+		Aliases:    mapper.Aliases(cliCommand),
+		Short:      mapper.ShortDescription(cliCommand),
+		Long:       mapper.Description(cliCommand),
+		Example:    commandExample,
+		Hidden:     mapper.CommandHidden(cliCommand),
+		Deprecated: mapper.CommandDeprecation(cliCommand),
 		CompletionOptions: cobra.CompletionOptions{
 			DisableDefaultCmd:   true,
 			DisableNoDescFlag:   true,
@@ -60,17 +82,59 @@ func buildCobraCommandFromCLICommand(ctx context.Context, commandName string, cl
 		DisableFlagsInUseLine: true,
 	}
 
+	if argsSpec != nil {
+		cobraCommand.Args = func(c *cobra.Command, args []string) error {
+			args, dashedArgs := getCommandArguments(c, args)
+			return showUsageOnHelpError(c, mapper.ParseArgs(argsSpec, args, dashedArgs))
+		}
+	}
+
 	if err := setCobraHooksFromCLIHooks(ctx, cobraCommand, mapper.Hook(cliCommand), mapper.PersistentHook(cliCommand)); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	localFlags, persistentFlags := mapper.Flags(cliCommand), mapper.PersistentFlags(cliCommand)
 	cli.SetInitializedFlagsInContext(ctx, localFlags, persistentFlags)
 
+	if err := mapper.CheckFlagsNames(slices.Concat(localFlags, persistentFlags)); err != nil {
+		return nil, nil, err
+	}
+
+	if err := mapper.CheckArgs(argsSpec); err != nil {
+		return nil, nil, err
+	}
+
+	// aliases are registered as flags of their own, which are hidden from help
+	localFlags, persistentFlags = mapper.FlagsWithAliases(localFlags), mapper.FlagsWithAliases(persistentFlags)
+
+	// flags may be reused across executions, values of a previous execution must not leak
+	mapper.ResetFlags(slices.Concat(localFlags, persistentFlags))
+
 	setCobraFlagsFromCLIFlags(cobraCommand.Flags(), localFlags)
 	setCobraFlagsFromCLIFlags(cobraCommand.PersistentFlags(), persistentFlags)
 
-	return cobraCommand, nil
+	setCobraHelpFromCLIHelp(ctx, cobraCommand, clihelp.Command{
+		Path:           path,
+		CLI:            c,
+		Flags:          slices.Concat(localFlags, persistentFlags),
+		InheritedFlags: inheritedFlags,
+	})
+
+	flags := slices.Concat(localFlags, persistentFlags, inheritedFlags)
+
+	// flags not provided are set from their environment variables right before the command hook
+	hook, flagsFromEnv := cobraCommand.PreRunE, mapper.NewFlagsFromEnv(flags)
+	cobraCommand.PreRunE = func(c *cobra.Command, args []string) error {
+		if err := flagsFromEnv(); err != nil {
+			return err
+		}
+
+		return hook(c, args)
+	}
+
+	cobraCommand.RunE = cobraHandlerFromCLIHandler(ctx, cliCommand, mapper.NewFlagsCheck(flags), mapper.NewDeprecationWarnings(c, flags))
+
+	return cobraCommand, persistentFlags, nil
 }
 
 // getCommandArguments separates the arguments passed to a command into positional arguments
@@ -90,25 +154,51 @@ func getCommandArguments(command *cobra.Command, args []string) ([]string, []str
 }
 
 // cobraHandlerFromCLIHandler adapts a `cli.Command`'s `Execute` method to the `cobra.Command`'s `RunE` function signature.
-// It handles the argument splitting and calls the `Execute` method with the appropriate context and arguments.
-// It also handles the `ShowHelpError`, displaying the command's usage if required.
-func cobraHandlerFromCLIHandler(ctx context.Context, cmd cli.Command) func(*cobra.Command, []string) error {
+// It handles the argument splitting, writes the deprecation warnings, checks the flags requirements and groups, and calls the
+// `Execute` method with the appropriate context and arguments. It also handles the `ShowHelpError`, displaying the command's
+// usage if required.
+func cobraHandlerFromCLIHandler(
+	ctx context.Context, cmd cli.Command, checkFlags func(context.Context) error, warnDeprecations func(context.Context),
+) func(*cobra.Command, []string) error {
 	return func(c *cobra.Command, args []string) error {
 		args, dashedArgs := getCommandArguments(c, args)
 
-		err := cmd.Execute(ctx, args, dashedArgs)
+		warnDeprecations(ctx)
 
-		var showHelpErr cli.ShowHelpError
-		if errors.As(err, &showHelpErr) {
-			if showHelpErr.ShowHelp() {
-				err = errors.Join(err, c.Usage())
-			}
+		if err := checkFlags(ctx); err != nil {
+			return showUsageOnHelpError(c, err)
 		}
 
-		return err
+		return showUsageOnHelpError(c, cmd.Execute(ctx, args, dashedArgs))
 	}
 }
 
+// showUsageOnHelpError displays the command's usage if the provided error is a `ShowHelpError` requesting it.
+func showUsageOnHelpError(c *cobra.Command, err error) error {
+	var showHelpErr cli.ShowHelpError
+	if errors.As(err, &showHelpErr) {
+		if showHelpErr.ShowHelp() {
+			err = errors.Join(err, c.Usage())
+		}
+	}
+
+	return err
+}
+
+// setCobraHelpFromCLIHelp renders the help and the usage of the `cobra.Command` with the help package.
+// Like cobra does, help is written to the standard output, and usage to the error output.
+func setCobraHelpFromCLIHelp(ctx context.Context, c *cobra.Command, help clihelp.Command) {
+	c.SetHelpFunc(func(c *cobra.Command, _ []string) {
+		if err := clihelp.Write(ctx, c.OutOrStdout(), help); err != nil {
+			c.PrintErrln(err)
+		}
+	})
+
+	c.SetUsageFunc(func(c *cobra.Command) error {
+		return clihelp.Write(ctx, c.OutOrStderr(), help)
+	})
+}
+
 // setCobraHooksFromCLIHooks sets the pre-run and post-run hooks for a `cobra.Command`
 // based on the `cli.Hook` and `cli.PersistentHook` provided. It ensures that persistent
 // hooks are executed in the correct order (parent first, then child).
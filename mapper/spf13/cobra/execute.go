@@ -6,15 +6,20 @@ import (
 
 	"github.com/krostar/test"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/krostar/cli"
-	mapper "github.com/krostar/cli/mapper/internal"
+	mapper "github.com/krostar/cli/internal/mapper"
+	"github.com/krostar/cli/internal/mapper/testwriter"
 )
 
 // Execute executes the CLI with the spf13/cobra backend.
 // It builds a Cobra command tree from the provided cli.CLI instance,
 // sets the command arguments, applies any provided options, and executes the command.
 //
+// Completion requests made by the scripts of the completion package are answered
+// instead of executing a command, as cobra own completion is not used.
+//
 // Note: The first argument in args (if present) is used as the CLI name and
 // removed from the argument list passed to the actual command.
 func Execute(ctx context.Context, args []string, c *cli.CLI, opts ...Option) error {
@@ -25,7 +30,17 @@ func Execute(ctx context.Context, args []string, c *cli.CLI, opts ...Option) err
 		args = args[1:]
 	}
 
-	command, err := buildCobraCommandFromCLIRecursively(ctx, c)
+	if mapper.CompletionRequested(args) {
+		// completion is answered without building the command tree, options are only applied to find the writer
+		probe := new(cobra.Command)
+		for _, opt := range opts {
+			opt(probe)
+		}
+
+		return mapper.WriteCompletion(ctx, probe.OutOrStdout(), c, args[1:])
+	}
+
+	command, err := buildCobraCommandFromCLIRecursively(ctx, c, nil, nil)
 	if err != nil {
 		return fmt.Errorf("unable not build cobra command from cli: %w", err)
 	}
@@ -36,9 +51,29 @@ func Execute(ctx context.Context, args []string, c *cli.CLI, opts ...Option) err
 		opt(command)
 	}
 
+	clearDeprecationsOfExecutedCommand(command, args)
+
 	return command.Execute()
 }
 
+// clearDeprecationsOfExecutedCommand clears the deprecation messages of the command executed with the provided
+// arguments, and of the flags it can use. Cobra and pflag write deprecation warnings to the output of the command,
+// while the mapper writes them to the exit logger, like the other mappers do.
+func clearDeprecationsOfExecutedCommand(command *cobra.Command, args []string) {
+	executed, _, err := command.Find(args)
+	if err != nil {
+		return
+	}
+
+	executed.Deprecated = ""
+
+	for c := executed; c != nil; c = c.Parent() {
+		for _, set := range []*pflag.FlagSet{c.Flags(), c.PersistentFlags()} {
+			set.VisitAll(func(flag *pflag.Flag) { flag.Deprecated = "" })
+		}
+	}
+}
+
 // Option is a function type for configuring a cobra.Command before execution.
 // This allows for customizing various aspects of the command behavior.
 type Option func(p *cobra.Command)
@@ -47,7 +82,7 @@ type Option func(p *cobra.Command)
 // It redirects both standard output and error streams to the test's logging system
 // with a "[CLI]: " prefix, making CLI output clearly identifiable in test logs.
 func ForTest(t test.TestingT) Option {
-	writer := mapper.TestWriter(t)
+	writer := testwriter.New(t)
 
 	return func(p *cobra.Command) {
 		p.SetOut(writer)
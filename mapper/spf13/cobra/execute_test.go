@@ -1,6 +1,7 @@
 package spf13cobra
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"strings"
@@ -25,6 +26,50 @@ func Test_Execute(t *testing.T) {
 		test.Assert(t, option1Called && option2Called)
 	})
 
+	t.Run("hidden and deprecated commands and flags", func(t *testing.T) {
+		var legacy string
+
+		newCLI := func() *cli.CLI {
+			return cli.New(double.NewFake(double.FakeWithPersistentFlags(func() []cli.Flag {
+				return []cli.Flag{cli.NewBuiltinFlag("legacy", "", &legacy, "", cli.WithDeprecated("use --name instead"))}
+			}))).
+				AddCommand("old", double.NewFake(), cli.WithDeprecatedCommand(`use "new" instead`)).
+				AddCommand("secret", double.NewFake(double.FakeWithHidden(func() bool { return true })))
+		}
+
+		t.Run("mapped onto cobra", func(t *testing.T) {
+			err := Execute(t.Context(), []string{"app"}, newCLI(), ForTest(t), func(root *cobra.Command) {
+				old, _, err := root.Find([]string{"old"})
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, old.Hidden && old.Deprecated == `use "new" instead`)
+
+				secret, _, err := root.Find([]string{"secret"})
+				test.Require(t, err == nil, "%v", err)
+				test.Assert(t, secret.Hidden && secret.Deprecated == "")
+
+				flag := root.PersistentFlags().Lookup("legacy")
+				test.Require(t, flag != nil)
+				test.Assert(t, flag.Hidden && flag.Deprecated == "use --name instead")
+			})
+			test.Assert(t, err == nil, "%v", err)
+		})
+
+		t.Run("warnings are only written to the exit logger", func(t *testing.T) {
+			var output, warnings closableBuffer
+
+			ctx := cli.NewContextWithMetadata(t.Context())
+			cli.SetExitLoggerInMetadata(ctx, &warnings)
+
+			err := Execute(ctx, []string{"app", "old", "--legacy", "l"}, newCLI(), func(root *cobra.Command) {
+				root.SetOut(&output)
+				root.SetErr(&output)
+			})
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, output.Len() == 0, output.String())
+			test.Assert(t, warnings.String() == "Command \"old\" is deprecated, use \"new\" instead\nFlag \"--legacy\" is deprecated, use --name instead\n", warnings.String())
+		})
+	})
+
 	t.Run("cli build failed", func(t *testing.T) {
 		anError := errors.New("boom")
 
@@ -37,9 +82,105 @@ func Test_Execute(t *testing.T) {
 		test.Assert(t, strings.Contains(err.Error(), "unable not build cobra command from cli"))
 	})
 
+	t.Run("help is rendered by the help package", func(t *testing.T) {
+		output := new(bytes.Buffer)
+
+		var (
+			verbose bool
+			port    int
+			token   string
+		)
+
+		c := cli.New(double.NewFake(
+			double.FakeWithPersistentFlags(func() []cli.Flag {
+				return []cli.Flag{cli.NewBuiltinFlag("verbose", "v", &verbose, "more logs")}
+			}),
+		)).AddCommand("serve", double.NewFake(
+			double.FakeWithDescription(func() string { return "serve things" }),
+			double.FakeWithUsage(func() string { return "<address>" }),
+			double.FakeWithArgs(func() *cli.ArgsSpec {
+				return &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{cli.NewArgWithValuer("address", nil, "where to listen")}}}
+			}),
+			double.FakeWithFlags(func() []cli.Flag {
+				return []cli.Flag{
+					cli.NewBuiltinFlag("port", "p", &port, "listen port"),
+					cli.NewBuiltinFlag("token", "", &token, "auth token", cli.WithHelpGroup("Security")),
+				}
+			}),
+		))
+
+		err := Execute(t.Context(), []string{"app", "serve", "--help"}, c, ForTest(t), func(cmd *cobra.Command) { cmd.SetOut(output) })
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, output.String() == `serve things
+
+Usage:
+  app serve [flags] <address>
+
+Arguments:
+  address   where to listen
+
+Flags:
+  -p, --port int   listen port
+  -h, --help       help for serve
+
+Security:
+      --token string   auth token
+
+Global Flags:
+  -v, --verbose   more logs
+`, output.String())
+	})
+
+	t.Run("completion request", func(t *testing.T) {
+		output := new(bytes.Buffer)
+
+		spy, spied := double.SpyCLI(cli.New(double.NewFake()).
+			AddCommand("serve", double.NewFake(double.FakeWithComplete(func(context.Context, []string, string) ([]string, error) {
+				return []string{"foo", "bar"}, nil
+			}))).
+			AddCommand("status", double.NewFake()))
+
+		err := Execute(t.Context(), []string{"app", "__complete", "serve", "f"}, spied, ForTest(t), func(cmd *cobra.Command) { cmd.SetOut(output) })
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, output.String() == "foo\n:4\n", output.String())
+		test.Assert(t, spy.CountCommandMethodCalls([]string{"app", "serve"}, "Execute") == 0)
+
+		t.Run("cobra help command and flags are not offered", func(t *testing.T) {
+			output := new(bytes.Buffer)
+			c := cli.New(double.NewFake()).AddCommand("serve", double.NewFake())
+
+			err := Execute(t.Context(), []string{"app", "__complete", ""}, c, ForTest(t), func(cmd *cobra.Command) { cmd.SetOut(output) })
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, output.String() == "serve\n:0\n", output.String())
+
+			output.Reset()
+
+			err = Execute(t.Context(), []string{"__complete", "--"}, c, ForTest(t), func(cmd *cobra.Command) { cmd.SetOut(output) })
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, output.String() == ":4\n", output.String())
+		})
+
+		t.Run("values of short-only flags are completed", func(t *testing.T) {
+			var level string
+
+			output := new(bytes.Buffer)
+			c := cli.New(double.NewFake(double.FakeWithFlags(func() []cli.Flag {
+				return []cli.Flag{cli.NewEnumFlag("", "l", &level, []string{"debug", "info"}, "")}
+			})))
+
+			err := Execute(t.Context(), []string{"app", "__complete", "-l", "i"}, c, ForTest(t), func(cmd *cobra.Command) { cmd.SetOut(output) })
+			test.Require(t, err == nil, "%v", err)
+			test.Assert(t, output.String() == "info\n:4\n", output.String())
+		})
+	})
+
 	t.Run("implementation checks", func(t *testing.T) {
 		mapper.AssertImplementation(t, func(t *testing.T, args []string, c *cli.CLI) error {
 			return Execute(t.Context(), args, c, ForTest(t))
 		})
 	})
 }
+
+type closableBuffer struct{ bytes.Buffer }
+
+func (*closableBuffer) Close() error { return nil }
@@ -4,15 +4,26 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
 )
 
 // setCobraFlagsFromCLIFlags adds flags to a `pflag.FlagSet` based on the provided `cli.Flag` slice.
+// Deprecated flags are marked like pflag MarkDeprecated does, but the deprecation warning is written
+// by the mapper to the exit logger, with the same format whatever the mapper, see Execute.
 func setCobraFlagsFromCLIFlags(set *pflag.FlagSet, flags []cli.Flag) {
 	for _, flag := range flags {
-		fset := set.VarPF(&flagValuer{flag}, flag.LongName(), flag.ShortName(), flag.Description())
+		fset := set.VarPF(&flagValuer{flag}, flag.LongName(), flag.ShortName(), mapper.FlagDescription(flag))
+		fset.Hidden = mapper.FlagHidden(flag)
+		fset.Deprecated = mapper.FlagDeprecation(flag)
+		fset.DefValue = mapper.FlagDefaultString(flag)
+
 		if _, isBool := flag.Destination().(*bool); isBool {
 			fset.NoOptDefVal = "true"
 		}
+
+		if mapper.FlagCounter(flag) {
+			fset.NoOptDefVal = cli.FlagCounterIncrement
+		}
 	}
 }
 
@@ -12,15 +12,15 @@ import (
 func Test_setCobraFlagsFromCLIFlags(t *testing.T) {
 	var (
 		s string
-		i int
+		i = 7
 		b bool
 	)
 
 	flagSet := pflag.NewFlagSet("test", pflag.ExitOnError)
 	setCobraFlagsFromCLIFlags(flagSet, []cli.Flag{
 		cli.NewBuiltinFlag("string-flag", "s", &s, "Test string flag description"),
-		cli.NewBuiltinFlag("int-flag", "", &i, "Test int flag description"),
-		cli.NewBuiltinFlag("bool-flag", "b", &b, "Test bool flag description"),
+		cli.NewBuiltinFlag("int-flag", "", &i, "Test int flag description", cli.WithHidden()),
+		cli.NewBuiltinFlag("bool-flag", "b", &b, "Test bool flag description", cli.WithDeprecated("use -s instead")),
 	})
 
 	{ // s
@@ -28,6 +28,7 @@ func Test_setCobraFlagsFromCLIFlags(t *testing.T) {
 		test.Assert(t, f != nil, "String flag should be registered")
 		test.Assert(t, f.Shorthand == "s", "String flag should have shorthand 's'")
 		test.Assert(t, f.Usage == "Test string flag description", "String flag should have correct usage")
+		test.Assert(t, !f.Hidden, "String flag should be visible")
 		test.Assert(t, f.Value.Set("str") == nil, "String flag should be settable")
 		test.Assert(t, s == "str", "String flag should set the variable correctly")
 	}
@@ -37,8 +38,10 @@ func Test_setCobraFlagsFromCLIFlags(t *testing.T) {
 		test.Assert(t, f != nil, "Int flag should be registered")
 		test.Assert(t, f.Shorthand == "", "Int flag should have no shorthand")
 		test.Assert(t, f.Usage == "Test int flag description", "Int flag should have correct usage")
+		test.Assert(t, f.Hidden, "Int flag should be hidden")
 		test.Assert(t, f.Value.Set("42") == nil, "Int flag should be settable")
 		test.Assert(t, i == 42, "Int flag should set the variable correctly")
+		test.Assert(t, f.DefValue == "7", "Int flag should keep its default value")
 	}
 
 	{ // b
@@ -46,6 +49,8 @@ func Test_setCobraFlagsFromCLIFlags(t *testing.T) {
 		test.Assert(t, f != nil, "Bool flag should be registered")
 		test.Assert(t, f.Shorthand == "b", "Bool flag should have shorthand 'b'")
 		test.Assert(t, f.Usage == "Test bool flag description", "Bool flag should have correct usage")
+		test.Assert(t, f.Hidden, "Deprecated bool flag should be hidden")
+		test.Assert(t, f.Deprecated == "use -s instead", "Deprecated bool flag should be marked as deprecated")
 		test.Assert(t, f.Value.Set("true") == nil, "Bool flag should be settable")
 		test.Assert(t, b, "Bool flag should set the variable correctly")
 	}
@@ -0,0 +1,353 @@
+package urfavecli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	urfave "github.com/urfave/cli/v3"
+
+	"github.com/krostar/cli"
+	clihelp "github.com/krostar/cli/help"
+	mapper "github.com/krostar/cli/internal/mapper"
+)
+
+// buildUrfaveCommandFromCLIRecursively constructs a `urfave.Command` from a `cli.CLI` instance.
+// It recursively processes subcommands, creating a tree of `urfave.Command`s that mirrors the
+// structure of the `cli.CLI`.
+// The parents are the names of the parents of the command, and the inheritedFlags their persistent flags, the nearest parent first.
+func buildUrfaveCommandFromCLIRecursively(ctx context.Context, c *cli.CLI, parents []string, inheritedFlags []cli.Flag) (*urfave.Command, error) {
+	ctx = cli.NewCommandContext(ctx)
+	ctx = mapper.Context(c.Command, ctx)
+
+	path := append(slices.Clone(parents), c.Name)
+
+	command, persistentFlags, err := buildUrfaveCommandFromCLICommand(ctx, c, path, inheritedFlags)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build command %s: %w", c.Name, err)
+	}
+
+	if err := mapper.CheckSubCommandsNames(c); err != nil {
+		return nil, fmt.Errorf("unable to build command %s: %w", c.Name, err)
+	}
+
+	inheritedFlags = slices.Concat(persistentFlags, inheritedFlags)
+
+	for _, subCommand := range c.SubCommands {
+		sub, err := buildUrfaveCommandFromCLIRecursively(ctx, subCommand, path, inheritedFlags)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build sub-command %s of command %s: %w", subCommand.Name, c.Name, err)
+		}
+
+		command.Commands = append(command.Commands, sub)
+	}
+
+	return command, nil
+}
+
+// buildUrfaveCommandFromCLICommand creates a single `urfave.Command` from the `cli.Command` of the provided `cli.CLI`.
+// It also returns the persistent flags of the command, aliases included, to be inherited by its subcommands.
+// Help is rendered by the help package, see newHelpFlag.
+func buildUrfaveCommandFromCLICommand(ctx context.Context, c *cli.CLI, path []string, inheritedFlags []cli.Flag) (*urfave.Command, []cli.Flag, error) {
+	cliCommand := c.Command
+
+	argsSpec := mapper.Args(cliCommand)
+	cli.SetInitializedArgsInContext(ctx, argsSpec)
+
+	hook := mapper.Hook(cliCommand)
+
+	urfaveCommand := &urfave.Command{
+		Name:        c.Name,
+		Aliases:     mapper.Aliases(cliCommand),
+		Usage:       mapper.ShortDescription(cliCommand),
+		Description: mapper.Description(cliCommand),
+		ArgsUsage:   mapper.Usage(cliCommand),
+		Hidden:      mapper.CommandHidden(cliCommand),
+		HideVersion: true,
+		HideHelp:    true,
+		// allows bundled short flags, like -vvv or -ab
+		UseShortOptionHandling: true,
+		OnUsageError: func(_ context.Context, _ *urfave.Command, err error, _ bool) error {
+			return err
+		},
+		ExitErrHandler: func(context.Context, *urfave.Command, error) {},
+	}
+
+	if err := setUrfaveHooksFromCLIHooks(ctx, urfaveCommand, mapper.PersistentHook(cliCommand)); err != nil {
+		return nil, nil, err
+	}
+
+	localFlags, persistentFlags := mapper.Flags(cliCommand), mapper.PersistentFlags(cliCommand)
+	cli.SetInitializedFlagsInContext(ctx, localFlags, persistentFlags)
+
+	if err := mapper.CheckFlagsNames(slices.Concat(localFlags, persistentFlags)); err != nil {
+		return nil, nil, err
+	}
+
+	if err := mapper.CheckArgs(argsSpec); err != nil {
+		return nil, nil, err
+	}
+
+	// aliases are registered as flags of their own, which are hidden from help
+	localFlags, persistentFlags = mapper.FlagsWithAliases(localFlags), mapper.FlagsWithAliases(persistentFlags)
+
+	// flags may be reused across executions, values of a previous execution must not leak
+	mapper.ResetFlags(slices.Concat(localFlags, persistentFlags))
+
+	urfaveCommand.Flags = append(urfaveCommand.Flags, urfaveFlagsFromCLIFlags(localFlags, true)...)
+	urfaveCommand.Flags = append(urfaveCommand.Flags, urfaveFlagsFromCLIFlags(persistentFlags, false)...)
+
+	help := clihelp.Command{
+		Path:           path,
+		CLI:            c,
+		Flags:          slices.Concat(localFlags, persistentFlags),
+		InheritedFlags: inheritedFlags,
+	}
+
+	writeHelp := func(w io.Writer) error { return clihelp.Write(ctx, w, help) }
+
+	flags := slices.Concat(localFlags, persistentFlags, inheritedFlags)
+
+	var helpRequested bool
+	urfaveCommand.Flags = append(urfaveCommand.Flags, newHelpFlag(flags, &helpRequested)...)
+
+	// help is shown, and arguments are validated, before any hook runs, like other mappers do
+	urfaveCommand.ArgValidator = func(actionCtx context.Context, c *urfave.Command) error {
+		if helpRequested {
+			if err := writeHelp(c.Root().Writer); err != nil {
+				return err
+			}
+
+			return errHelpShown
+		}
+
+		args, dashedArgs := getCommandArguments(actionCtx, c)
+
+		return showHelpOnHelpError(c, writeHelp, mapper.ParseArgs(argsSpec, args, dashedArgs))
+	}
+
+	urfaveCommand.Action = urfaveActionFromCLIHandler(
+		ctx, cliCommand, hook, writeHelp, mapper.NewFlagsFromEnv(flags), mapper.NewFlagsCheck(flags), mapper.NewDeprecationWarnings(c, flags),
+	)
+
+	return urfaveCommand, persistentFlags, nil
+}
+
+// getCommandArguments separates the arguments passed to a command into positional arguments
+// and dashed arguments (arguments after "--"). As urfave/cli drops the "--" separator while
+// parsing, the number of dashed arguments is found by going through the arguments the command parsed again.
+func getCommandArguments(ctx context.Context, command *urfave.Command) ([]string, []string) {
+	args := command.Args().Slice()
+	dashedArgsCount := min(countDashedArgs(command, getCommandRawArgs(ctx, command)), len(args))
+	argsSeparatedAt := len(args) - dashedArgsCount
+
+	switch {
+	case len(args) == 0:
+		return nil, nil
+	case argsSeparatedAt == 0:
+		return nil, args
+	case dashedArgsCount > 0:
+		return args[:argsSeparatedAt], args[argsSeparatedAt:]
+	default:
+		return args, nil
+	}
+}
+
+// getCommandRawArgs returns the arguments the provided command parsed: the arguments left by
+// the parsing of its parent, its own name excluded, or the arguments provided to the root command.
+func getCommandRawArgs(ctx context.Context, command *urfave.Command) []string {
+	lineage := command.Lineage()
+	if len(lineage) < 2 {
+		return getArgsFromContext(ctx)
+	}
+
+	if parentArgs := lineage[1].Args().Slice(); len(parentArgs) > 0 {
+		return parentArgs[1:]
+	}
+
+	return nil
+}
+
+// countDashedArgs returns the number of arguments provided after the "--" separator, if any.
+// Arguments are gone through like urfave/cli parses them, such that a "--" used as a flag value is not a separator.
+func countDashedArgs(command *urfave.Command, args []string) int {
+	for i := 0; i < len(args); i++ {
+		arg := strings.TrimSpace(args[i])
+
+		switch {
+		case arg == "--":
+			return len(args) - i - 1
+		case arg == "-" || !strings.HasPrefix(arg, "-"):
+			continue
+		case !strings.HasPrefix(arg, "--"):
+			// urfave/cli stops parsing flags on single dashed arguments not followed by a letter, like -1
+			if r, _ := utf8.DecodeRuneInString(arg[1:]); !unicode.IsLetter(r) {
+				return 0
+			}
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(arg[1:], "-"), "=")
+		if flag := lookupCommandFlag(command, name); flag != nil || strings.HasPrefix(arg, "--") {
+			if !hasValue && flag != nil && !isBoolFlag(flag) {
+				i++ // the next argument is the flag value
+			}
+
+			continue
+		}
+
+		// short flags may be bundled, only the last one of them can take a value
+		if _, lastSize := utf8.DecodeLastRuneInString(name); value == "" && lastSize > 0 {
+			if flag := lookupCommandFlag(command, name[len(name)-lastSize:]); flag != nil && !isBoolFlag(flag) {
+				i++
+			}
+		}
+	}
+
+	return 0
+}
+
+// lookupCommandFlag returns the flag of the provided command, or the non-local flag of its parents
+// not shadowed by the command flags, with the provided name.
+func lookupCommandFlag(command *urfave.Command, name string) urfave.Flag {
+	commandFlagsNames := make(map[string]bool)
+
+	for _, flag := range command.Flags {
+		for _, flagName := range flag.Names() {
+			commandFlagsNames[flagName] = true
+		}
+
+		if slices.Contains(flag.Names(), name) {
+			return flag
+		}
+	}
+
+	for _, parent := range command.Lineage()[1:] {
+		for _, flag := range parent.Flags {
+			if local, ok := flag.(urfave.LocalFlag); ok && local.IsLocal() {
+				continue
+			}
+
+			if slices.ContainsFunc(flag.Names(), func(flagName string) bool { return commandFlagsNames[flagName] }) {
+				continue
+			}
+
+			if slices.Contains(flag.Names(), name) {
+				return flag
+			}
+		}
+	}
+
+	return nil
+}
+
+func isBoolFlag(flag urfave.Flag) bool {
+	boolFlag, ok := flag.(interface{ IsBoolFlag() bool })
+	return ok && boolFlag.IsBoolFlag()
+}
+
+// urfaveActionFromCLIHandler adapts a `cli.Command`'s `Execute` method to the `urfave.Command`'s `Action` function signature.
+// It handles the argument splitting, sets the flags not provided from their environment variables, runs the command hooks around the `Execute` call, writes the deprecation
+// warnings and checks the flags requirements and groups once the hooks ran, and handles the `ShowHelpError`, displaying the
+// command's help if required. Arguments are validated beforehand, by the `ArgValidator` of the `urfave.Command`.
+func urfaveActionFromCLIHandler(
+	ctx context.Context, cmd cli.Command, hook *cli.Hook, writeHelp func(io.Writer) error,
+	flagsFromEnv func() error, checkFlags func(context.Context) error, warnDeprecations func(context.Context),
+) urfave.ActionFunc {
+	return func(actionCtx context.Context, c *urfave.Command) error {
+		args, dashedArgs := getCommandArguments(actionCtx, c)
+
+		if err := flagsFromEnv(); err != nil {
+			return err
+		}
+
+		if err := hook.BeforeCommandExecution(ctx); err != nil {
+			return err
+		}
+
+		warnDeprecations(ctx)
+
+		err := checkFlags(ctx)
+		if err == nil {
+			err = cmd.Execute(ctx, args, dashedArgs)
+		}
+
+		err = showHelpOnHelpError(c, writeHelp, err)
+
+		return errors.Join(err, hook.AfterCommandExecution(ctx))
+	}
+}
+
+// showHelpOnHelpError displays the command's help if the provided error is a `ShowHelpError` requesting it.
+func showHelpOnHelpError(c *urfave.Command, writeHelp func(io.Writer) error, err error) error {
+	var showHelpErr cli.ShowHelpError
+	if errors.As(err, &showHelpErr) {
+		if showHelpErr.ShowHelp() {
+			err = errors.Join(err, writeHelp(c.Root().Writer))
+		}
+	}
+
+	return err
+}
+
+// errHelpShown is returned by commands whose help flag is provided, once their help is shown,
+// to stop the execution. It is not returned by Execute.
+var errHelpShown = errors.New("help shown")
+
+// newHelpFlag returns the help flag of the command, named --help and -h unless these names are already used by the flags.
+// The urfave/cli help flag and help command are disabled, for help to be rendered by the help package, and for
+// arguments named "help" to be provided to commands, like other mappers do.
+func newHelpFlag(flags []cli.Flag, requested *bool) []urfave.Flag {
+	longName, shortName := "help", "h"
+
+	for _, flag := range flags {
+		if flag.LongName() == longName {
+			longName = ""
+		}
+
+		if flag.ShortName() == shortName {
+			shortName = ""
+		}
+	}
+
+	if longName == "" && shortName == "" {
+		return nil
+	}
+
+	return urfaveFlagsFromCLIFlags([]cli.Flag{cli.NewBuiltinFlag(longName, shortName, requested, "")}, true)
+}
+
+// setUrfaveHooksFromCLIHooks sets the before and after hooks for a `urfave.Command`
+// based on the `cli.PersistentHook` provided. urfave/cli runs the before hooks of
+// the whole command chain (parent first, then child), and the after hooks in the
+// reverse order, which matches the persistent hooks semantic. As urfave/cli runs the after hooks
+// of the parents even when the before hooks did not run, like when arguments are invalid,
+// after hooks only run once their before hook ran.
+func setUrfaveHooksFromCLIHooks(ctx context.Context, c *urfave.Command, persistentHook *cli.PersistentHook) error {
+	if err := persistentHook.BeforeFlagsDefinition(ctx); err != nil {
+		return fmt.Errorf("pre-flag-definition hook failed: %w", err)
+	}
+
+	var beforeRan bool
+
+	c.Before = func(context.Context, *urfave.Command) (context.Context, error) {
+		beforeRan = true
+		return nil, persistentHook.BeforeCommandExecution(ctx)
+	}
+
+	c.After = func(context.Context, *urfave.Command) error {
+		if !beforeRan {
+			return nil
+		}
+
+		beforeRan = false
+
+		return persistentHook.AfterCommandExecution(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package urfavecli
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/krostar/test"
+	urfave "github.com/urfave/cli/v3"
+
+	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
+	"github.com/krostar/cli/internal/mapper/testwriter"
+)
+
+// Execute executes the CLI with the urfave/cli backend.
+// It builds an urfave command tree from the provided cli.CLI instance,
+// applies any provided options, and runs the command with the arguments.
+//
+// Completion requests made by the scripts of the completion package are answered
+// instead of executing a command, as urfave/cli own completion protocol is not used.
+// Help is rendered by the help package instead of the urfave/cli templates.
+//
+// Note: The first argument in args (if present) is used as the CLI name and
+// removed from the argument list passed to the actual command.
+func Execute(ctx context.Context, args []string, c *cli.CLI, opts ...Option) error {
+	// set CLI name from the first argument (typically the binary name)
+	// and remove it from the arguments passed to the command
+	if c.Name == "" && len(args) > 0 {
+		c.Name = args[0]
+		args = args[1:]
+	}
+
+	if mapper.CompletionRequested(args) {
+		// completion is answered without building the command tree, options are only applied to find the writer
+		probe := new(urfave.Command)
+		for _, opt := range opts {
+			opt(probe)
+		}
+
+		return mapper.WriteCompletion(ctx, cmp.Or(probe.Writer, io.Writer(os.Stdout)), c, args[1:])
+	}
+
+	command, err := buildUrfaveCommandFromCLIRecursively(ctx, c, nil, nil)
+	if err != nil {
+		return fmt.Errorf("unable to build urfave command from cli: %w", err)
+	}
+
+	for _, opt := range opts {
+		opt(command)
+	}
+
+	// urfave/cli expects the program name as first argument
+	err = command.Run(setArgsInContext(ctx, args), append([]string{c.Name}, args...))
+	if errors.Is(err, errHelpShown) {
+		return nil
+	}
+
+	return err
+}
+
+// Option is a function type for configuring an urfave.Command before execution.
+// This allows for customizing various aspects of the command behavior.
+type Option func(c *urfave.Command)
+
+// ForTest returns an Option that configures an urfave.Command for testing purposes.
+// It redirects both standard output and error streams to the test's logging system
+// with a "[CLI]: " prefix, making CLI output clearly identifiable in test logs.
+func ForTest(t test.TestingT) Option {
+	writer := testwriter.New(t)
+
+	return func(c *urfave.Command) {
+		c.Writer = writer
+		c.ErrWriter = writer
+	}
+}
+
+type ctxKey uint8
+
+const ctxKeyArgs ctxKey = iota + 1
+
+// setArgsInContext stores the arguments provided to the root command, the program name excluded.
+func setArgsInContext(ctx context.Context, args []string) context.Context {
+	return context.WithValue(ctx, ctxKeyArgs, args)
+}
+
+func getArgsFromContext(ctx context.Context) []string {
+	args, _ := ctx.Value(ctxKeyArgs).([]string) //nolint:revive,errcheck // unchecked-type-assertion: zero value is fine
+	return args
+}
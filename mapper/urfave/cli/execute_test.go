@@ -0,0 +1,170 @@
+package urfavecli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+	urfave "github.com/urfave/cli/v3"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+	"github.com/krostar/cli/mapper"
+)
+
+func Test_Execute(t *testing.T) {
+	t.Run("applies all options", func(t *testing.T) {
+		option1Called, option2Called := false, false
+		option1 := func(*urfave.Command) { option1Called = true }
+		option2 := func(*urfave.Command) { option2Called = true }
+
+		err := Execute(t.Context(), []string{"root"}, cli.New(double.NewFake()), option1, option2)
+		test.Assert(t, err == nil, "%v", err)
+		test.Assert(t, option1Called && option2Called)
+	})
+
+	t.Run("cli build failed", func(t *testing.T) {
+		anError := errors.New("boom")
+
+		c := cli.New(double.NewFake(double.FakeWithPersistentHook(func() *cli.PersistentHook {
+			return &cli.PersistentHook{BeforeFlagsDefinition: func(context.Context) error { return anError }}
+		})))
+
+		err := Execute(t.Context(), nil, c, ForTest(t))
+		test.Require(t, err != nil && errors.Is(err, anError), "%v", err)
+		test.Assert(t, strings.Contains(err.Error(), "unable to build urfave command from cli"))
+	})
+
+	t.Run("help is rendered by the help package", func(t *testing.T) {
+		output := new(bytes.Buffer)
+
+		var (
+			verbose bool
+			port    int
+			token   string
+		)
+
+		c := cli.New(double.NewFake(
+			double.FakeWithPersistentFlags(func() []cli.Flag {
+				return []cli.Flag{cli.NewBuiltinFlag("verbose", "v", &verbose, "more logs")}
+			}),
+		)).AddCommand("serve", double.NewFake(
+			double.FakeWithDescription(func() string { return "serve things" }),
+			double.FakeWithUsage(func() string { return "<address>" }),
+			double.FakeWithArgs(func() *cli.ArgsSpec {
+				return &cli.ArgsSpec{Positional: &cli.ArgList{Args: []cli.Arg{cli.NewArgWithValuer("address", nil, "where to listen")}}}
+			}),
+			double.FakeWithFlags(func() []cli.Flag {
+				return []cli.Flag{
+					cli.NewBuiltinFlag("port", "p", &port, "listen port"),
+					cli.NewBuiltinFlag("token", "", &token, "auth token", cli.WithHelpGroup("Security")),
+				}
+			}),
+		))
+
+		err := Execute(t.Context(), []string{"app", "serve", "--help"}, c, ForTest(t), func(cmd *urfave.Command) { cmd.Writer = output })
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, output.String() == `serve things
+
+Usage:
+  app serve [flags] <address>
+
+Arguments:
+  address   where to listen
+
+Flags:
+  -p, --port int   listen port
+  -h, --help       help for serve
+
+Security:
+      --token string   auth token
+
+Global Flags:
+  -v, --verbose   more logs
+`, output.String())
+	})
+
+	t.Run("help flag names in use are left to flags", func(t *testing.T) {
+		output := new(bytes.Buffer)
+
+		var host string
+
+		err := Execute(t.Context(), []string{"app", "-h", "localhost"}, cli.New(double.NewFake(
+			double.FakeWithFlags(func() []cli.Flag { return []cli.Flag{cli.NewBuiltinFlag("host", "h", &host, "")} }),
+		)), ForTest(t), func(cmd *urfave.Command) { cmd.Writer = output })
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, host == "localhost" && output.Len() == 0, output.String())
+
+		var helpHost string
+
+		err = Execute(t.Context(), []string{"app", "--help"}, cli.New(double.NewFake(
+			double.FakeWithFlags(func() []cli.Flag { return []cli.Flag{cli.NewBuiltinFlag("host", "h", &helpHost, "")} }),
+		)), ForTest(t), func(cmd *urfave.Command) { cmd.Writer = output })
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, strings.Contains(output.String(), "  -h, --host string\n      --help          help for app\n"), output.String())
+	})
+
+	t.Run("global help printer is not used", func(t *testing.T) {
+		helpPrinter := urfave.HelpPrinter
+		t.Cleanup(func() { urfave.HelpPrinter = helpPrinter })
+
+		var printerCalled bool
+		urfave.HelpPrinter = func(io.Writer, string, any) { printerCalled = true }
+
+		output := new(bytes.Buffer)
+
+		err := Execute(t.Context(), []string{"app", "--help"}, cli.New(double.NewFake()), ForTest(t), func(cmd *urfave.Command) { cmd.Writer = output })
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, !printerCalled && strings.HasPrefix(output.String(), "Usage:"), output.String())
+	})
+
+	t.Run("completion request", func(t *testing.T) {
+		output := new(bytes.Buffer)
+
+		spy, spied := double.SpyCLI(cli.New(double.NewFake()).
+			AddCommand("serve", double.NewFake(double.FakeWithComplete(func(context.Context, []string, string) ([]string, error) {
+				return []string{"foo", "bar"}, nil
+			}))).
+			AddCommand("status", double.NewFake()))
+
+		err := Execute(t.Context(), []string{"app", "__complete", "s"}, spied, func(cmd *urfave.Command) { cmd.Writer = output })
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, output.String() == "serve\nstatus\n:0\n", output.String())
+		test.Assert(t, spy.CountCommandMethodCalls([]string{"app"}, "Execute") == 0)
+	})
+
+	t.Run("dashes used as value of bundled short flags are not separators", func(t *testing.T) {
+		var (
+			verbose                      bool
+			str                          string
+			capturedArgs, capturedDashed []string
+		)
+
+		c := cli.New(double.NewFake(
+			double.FakeWithFlags(func() []cli.Flag {
+				return []cli.Flag{cli.NewBuiltinFlag("verbose", "v", &verbose, ""), cli.NewBuiltinFlag("str", "s", &str, "")}
+			}),
+			double.FakeWithExecute(func(_ context.Context, args, dashedArgs []string) error {
+				capturedArgs, capturedDashed = args, dashedArgs
+				return nil
+			}),
+		))
+
+		err := Execute(t.Context(), []string{"app", "-vs", "--", "a", "--", "b"}, c, ForTest(t))
+		test.Require(t, err == nil, "%v", err)
+		test.Assert(t, verbose && str == "--", "%t %q", verbose, str)
+		test.Assert(check.Compare(t, capturedArgs, []string{"a"}))
+		test.Assert(check.Compare(t, capturedDashed, []string{"b"}))
+	})
+
+	t.Run("implementation checks", func(t *testing.T) {
+		mapper.AssertImplementation(t, func(t *testing.T, args []string, c *cli.CLI) error {
+			return Execute(t.Context(), args, c, ForTest(t))
+		})
+	})
+}
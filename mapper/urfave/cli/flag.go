@@ -0,0 +1,60 @@
+package urfavecli
+
+import (
+	urfave "github.com/urfave/cli/v3"
+
+	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
+)
+
+// urfaveFlagsFromCLIFlags creates `urfave.Flag`s based on the provided `cli.Flag` slice.
+// Local flags are only applied to the command defining them, while non-local flags
+// are inherited by all subcommands.
+func urfaveFlagsFromCLIFlags(flags []cli.Flag, local bool) []urfave.Flag {
+	urfaveFlags := make([]urfave.Flag, 0, len(flags))
+
+	for _, flag := range flags {
+		name, aliases := flag.LongName(), []string(nil)
+		if shortName := flag.ShortName(); shortName != "" {
+			if name == "" {
+				name = shortName
+			} else {
+				aliases = []string{shortName}
+			}
+		}
+
+		urfaveFlags = append(urfaveFlags, &urfave.GenericFlag{
+			Name:        name,
+			Aliases:     aliases,
+			Usage:       mapper.FlagDescription(flag),
+			Hidden:      mapper.FlagHidden(flag),
+			Value:       &flagValuer{FlagValuer: flag, counter: mapper.FlagCounter(flag)},
+			DefaultText: mapper.FlagDefaultString(flag),
+			Local:       local,
+		})
+	}
+
+	return urfaveFlags
+}
+
+// flagValuer adapts cli.FlagValuer to urfave's flag values. Counter flags are handled as
+// boolean flags, to be usable without value, and are incremented when no value is provided.
+type flagValuer struct {
+	cli.FlagValuer
+	counter bool
+}
+
+func (flag *flagValuer) Set(raw string) error {
+	if flag.counter && raw == "true" {
+		raw = cli.FlagCounterIncrement
+	}
+
+	return flag.FromString(raw)
+}
+
+func (flag *flagValuer) Get() any       { return flag.Destination() }
+func (flag *flagValuer) String() string { return flag.FlagValuer.String() }
+func (flag *flagValuer) IsBoolFlag() bool {
+	_, isBool := flag.Destination().(*bool)
+	return isBool || flag.counter
+}
@@ -0,0 +1,60 @@
+package urfavecli
+
+import (
+	"testing"
+
+	"github.com/krostar/test"
+	urfave "github.com/urfave/cli/v3"
+
+	"github.com/krostar/cli"
+)
+
+func Test_urfaveFlagsFromCLIFlags(t *testing.T) {
+	var (
+		s string
+		i = 7
+		b bool
+	)
+
+	flags := urfaveFlagsFromCLIFlags([]cli.Flag{
+		cli.NewBuiltinFlag("string-flag", "s", &s, "Test string flag description"),
+		cli.NewBuiltinFlag("int-flag", "", &i, "Test int flag description", cli.WithHidden()),
+		cli.NewBuiltinFlag("", "b", &b, "Test bool flag description", cli.WithDeprecated("use -s instead")),
+	}, true)
+	test.Require(t, len(flags) == 3)
+
+	{ // s
+		f, ok := flags[0].(*urfave.GenericFlag)
+		test.Require(t, ok, "String flag should be a generic flag")
+		test.Assert(t, f.Name == "string-flag" && len(f.Aliases) == 1 && f.Aliases[0] == "s", "String flag should have long name and short alias")
+		test.Assert(t, f.Usage == "Test string flag description", "String flag should have correct usage")
+		test.Assert(t, !f.Hidden, "String flag should be visible")
+		test.Assert(t, f.IsLocal(), "String flag should be local")
+		test.Assert(t, f.Value.Set("str") == nil, "String flag should be settable")
+		test.Assert(t, s == "str", "String flag should set the variable correctly")
+	}
+
+	{ // i
+		f, ok := flags[1].(*urfave.GenericFlag)
+		test.Require(t, ok, "Int flag should be a generic flag")
+		test.Assert(t, f.Name == "int-flag" && len(f.Aliases) == 0, "Int flag should have no alias")
+		test.Assert(t, f.Usage == "Test int flag description", "Int flag should have correct usage")
+		test.Assert(t, f.Hidden, "Int flag should be hidden")
+		test.Assert(t, f.Value.Set("42") == nil, "Int flag should be settable")
+		test.Assert(t, i == 42, "Int flag should set the variable correctly")
+		test.Assert(t, f.DefaultText == "7", "Int flag should keep its default value")
+	}
+
+	{ // b
+		f, ok := flags[2].(*urfave.GenericFlag)
+		test.Require(t, ok, "Bool flag should be a generic flag")
+		test.Assert(t, f.Name == "b" && len(f.Aliases) == 0, "Bool flag should use its short name as name")
+		test.Assert(t, f.Usage == "Test bool flag description", "Bool flag should have correct usage")
+		test.Assert(t, f.Hidden, "Deprecated bool flag should be hidden")
+		test.Assert(t, f.Value.(*flagValuer).IsBoolFlag(), "Bool flag should not require a value")
+		test.Assert(t, f.Value.Set("true") == nil, "Bool flag should be settable")
+		test.Assert(t, b, "Bool flag should set the variable correctly")
+	}
+
+	test.Assert(t, !urfaveFlagsFromCLIFlags([]cli.Flag{cli.NewBuiltinFlag("bool", "", &b, "")}, false)[0].(*urfave.GenericFlag).IsLocal())
+}
@@ -0,0 +1,57 @@
+package clischema
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/krostar/cli"
+)
+
+// CommandName is the name of the hidden command added by AddCommand.
+const CommandName = "__schema"
+
+// CommandOption defines options to customize the command added by AddCommand.
+type CommandOption func(*command)
+
+// WithWriter changes the writer the schema is written to, which is the standard output by default.
+func WithWriter(w io.Writer) CommandOption {
+	return func(c *command) { c.writer = w }
+}
+
+// AddCommand adds to the provided CLI the hidden CommandName command, writing the schema of the CLI, see Write.
+// The root command is named after the base name of the executed program, like "app".
+//
+// Example:
+//
+//	cmd := clischema.AddCommand(cli.New(rootCommand{}).AddCommand("serve", serveCommand{}))
+//	err := spf13cobra.Execute(ctx, os.Args, cmd) // app __schema > schema.json
+func AddCommand(c *cli.CLI, opts ...CommandOption) *cli.CLI {
+	cmd := &command{root: c, writer: os.Stdout}
+	for _, opt := range opts {
+		opt(cmd)
+	}
+
+	return c.AddCommand(CommandName, cmd)
+}
+
+type command struct {
+	root   *cli.CLI
+	writer io.Writer
+}
+
+func (*command) Hidden() bool { return true }
+
+func (*command) Description() string {
+	return "Write the JSON schema of the commands, arguments and flags of the application"
+}
+
+func (c *command) Execute(ctx context.Context, _, _ []string) error {
+	root := *c.root
+	if root.Name != "" {
+		root.Name = filepath.Base(root.Name)
+	}
+
+	return Write(ctx, c.writer, &root)
+}
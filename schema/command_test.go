@@ -0,0 +1,40 @@
+package clischema
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+	"github.com/krostar/cli/internal/clitest"
+	"github.com/krostar/cli/internal/mapper"
+	"github.com/krostar/cli/mapper/native"
+)
+
+func Test_AddCommand(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		c := AddCommand(clitest.NewCLI(), WithWriter(&buf))
+
+		sub := c.SubCommands[len(c.SubCommands)-1]
+		test.Assert(t, sub.Name == CommandName)
+		test.Assert(t, mapper.CommandHidden(sub.Command))
+		test.Assert(t, mapper.Description(sub.Command) != "")
+
+		test.Require(t, native.Execute(t.Context(), []string{"/usr/bin/app", CommandName}, c) == nil)
+		test.Assert(check.Compare(t, buf.String(), expectedSchema))
+		test.Assert(t, c.Name == "/usr/bin/app", "executed CLI is left untouched")
+	})
+
+	t.Run("write failure", func(t *testing.T) {
+		c := AddCommand(cli.New(double.NewFake()), WithWriter(failingWriter{}))
+
+		err := native.Execute(t.Context(), []string{"app", CommandName}, c)
+		test.Assert(t, err != nil && errors.Is(err, errWrite), err)
+	})
+}
@@ -0,0 +1,288 @@
+// Package clischema describes the surface of a CLI, its commands, arguments and flags, in a stable JSON document.
+//
+// Schemas allow tools, like wrapper scripts, IDE integrations or compatibility tests, to introspect
+// a CLI without parsing its help. They are generated with New, or by executing the hidden command
+// added by AddCommand, like "app __schema". Unlike help and documentation, schemas describe hidden
+// and deprecated commands and flags, marked as such.
+//
+// The document is stable: the same CLI always produces the same document, commands and flags being
+// in the order they are defined. Fields are only added to the format, a new Version being released
+// if existing fields change.
+package clischema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/krostar/cli"
+	mapper "github.com/krostar/cli/internal/mapper"
+)
+
+// Version is the version of the format of the schemas generated by this package.
+const Version = 1
+
+// Schema describes the surface of a CLI.
+type Schema struct {
+	// Version is the version of the format of the schema.
+	Version int `json:"version"`
+	// Command is the root command, named after the program.
+	Command *Command `json:"command"`
+}
+
+// Command describes a command.
+type Command struct {
+	Name        string   `json:"name"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Usage       string   `json:"usage,omitempty"`
+	Examples    []string `json:"examples,omitempty"`
+	Args        *Args    `json:"args,omitempty"`
+	Flags       []Flag   `json:"flags,omitempty"`
+	Hooks       *Hooks   `json:"hooks,omitempty"`
+	// Hidden is true for commands not listed in the help of their parent, which is the case of deprecated commands.
+	Hidden      bool       `json:"hidden,omitempty"`
+	Deprecation string     `json:"deprecation,omitempty"`
+	SubCommands []*Command `json:"subCommands,omitempty"`
+}
+
+// Args describes the arguments accepted by a command, see cli.ArgsSpec.
+type Args struct {
+	Positional *ArgList `json:"positional,omitempty"`
+	Dashed     *ArgList `json:"dashed,omitempty"`
+}
+
+// ArgList describes an ordered list of arguments, see cli.ArgList.
+type ArgList struct {
+	Args        []Arg `json:"args,omitempty"`
+	Variadic    *Arg  `json:"variadic,omitempty"`
+	MinVariadic int   `json:"minVariadic,omitempty"`
+	MaxVariadic int   `json:"maxVariadic,omitempty"`
+}
+
+// Arg describes an argument.
+type Arg struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	// Required is true for the arguments that can't be omitted, variadic arguments included when MinVariadic is set.
+	Required bool `json:"required,omitempty"`
+}
+
+// Flag describes a flag.
+type Flag struct {
+	LongName    string `json:"longName,omitempty"`
+	ShortName   string `json:"shortName,omitempty"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	// Default is the representation of the default value of the flag.
+	Default string `json:"default,omitempty"`
+	// Persistent is true for flags inherited by the subcommands.
+	Persistent bool `json:"persistent,omitempty"`
+	Required   bool `json:"required,omitempty"`
+	// Hidden is true for flags not listed in help, which is the case of deprecated flags.
+	Hidden      bool        `json:"hidden,omitempty"`
+	Deprecation string      `json:"deprecation,omitempty"`
+	Aliases     []FlagAlias `json:"aliases,omitempty"`
+	Env         []string    `json:"env,omitempty"`
+	// Values are the values accepted by the flag, for flags restricted to a set of values.
+	Values      []string `json:"values,omitempty"`
+	Constraints []string `json:"constraints,omitempty"`
+}
+
+// FlagAlias describes an additional long name of a flag, see cli.FlagAlias.
+type FlagAlias struct {
+	Name       string `json:"name"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
+
+// Hooks describes the hooks defined by a command, see cli.Hook and cli.PersistentHook.
+type Hooks struct {
+	BeforeCommandExecution           bool `json:"beforeCommandExecution,omitempty"`
+	AfterCommandExecution            bool `json:"afterCommandExecution,omitempty"`
+	PersistentBeforeFlagsDefinition  bool `json:"persistentBeforeFlagsDefinition,omitempty"`
+	PersistentBeforeCommandExecution bool `json:"persistentBeforeCommandExecution,omitempty"`
+	PersistentAfterCommandExecution  bool `json:"persistentAfterCommandExecution,omitempty"`
+}
+
+// New returns the schema of the provided CLI. The BeforeFlagsDefinition persistent hook of each command runs before
+// its flags are created, as it does when the CLI is executed, while the other hooks do not run: their presence is only
+// described. The command added by AddCommand is not part of the schema.
+func New(ctx context.Context, c *cli.CLI) (*Schema, error) {
+	var (
+		root   *Command
+		byNode = make(map[*mapper.CommandNode]*Command)
+	)
+
+	if err := mapper.WalkCommands(ctx, c, func(_ context.Context, node *mapper.CommandNode) error {
+		if _, isSchemaCommand := node.CLI.Command.(*command); isSchemaCommand {
+			return nil
+		}
+
+		parent, hasParent := byNode[node.Parent]
+		if node.Parent != nil && !hasParent {
+			return nil
+		}
+
+		cmd := newCommand(node)
+		byNode[node] = cmd
+
+		if node.Parent == nil {
+			root = cmd
+		} else {
+			parent.SubCommands = append(parent.SubCommands, cmd)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("unable to walk commands: %w", err)
+	}
+
+	return &Schema{Version: Version, Command: root}, nil
+}
+
+// Write writes the schema of the provided CLI, see New, as indented JSON.
+func Write(ctx context.Context, w io.Writer, c *cli.CLI) error {
+	schema, err := New(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(schema); err != nil {
+		return fmt.Errorf("unable to write schema: %w", err)
+	}
+
+	return nil
+}
+
+// Read reads a schema written by Write. Schemas of other versions of the format are rejected.
+func Read(r io.Reader) (*Schema, error) {
+	var schema Schema
+
+	if err := json.NewDecoder(r).Decode(&schema); err != nil {
+		return nil, fmt.Errorf("unable to read schema: %w", err)
+	}
+
+	if schema.Version != Version {
+		return nil, fmt.Errorf("unsupported schema version %d, expected %d", schema.Version, Version)
+	}
+
+	if schema.Command == nil {
+		return nil, errors.New("schema does not describe any command")
+	}
+
+	return &schema, nil
+}
+
+func newCommand(node *mapper.CommandNode) *Command {
+	cmd := node.CLI.Command
+
+	c := &Command{
+		Name:        node.CLI.Name,
+		Description: mapper.Description(cmd),
+		Usage:       mapper.Usage(cmd),
+		Examples:    mapper.Examples(cmd),
+		Args:        newArgs(mapper.Args(cmd)),
+		Flags:       append(newFlags(node.Flags, false), newFlags(node.PersistentFlags, true)...),
+		Hooks:       newHooks(cmd),
+		Hidden:      mapper.CommandHidden(node.CLI.Command),
+		Deprecation: mapper.CommandDeprecation(node.CLI.Command),
+	}
+
+	if node.Parent != nil {
+		c.Aliases = mapper.Aliases(cmd)
+	}
+
+	return c
+}
+
+func newArgs(spec *cli.ArgsSpec) *Args {
+	if spec == nil || (spec.Positional == nil && spec.Dashed == nil) {
+		return nil
+	}
+
+	return &Args{Positional: newArgList(spec.Positional), Dashed: newArgList(spec.Dashed)}
+}
+
+func newArgList(list *cli.ArgList) *ArgList {
+	if list == nil {
+		return nil
+	}
+
+	l := &ArgList{MinVariadic: list.MinVariadic, MaxVariadic: list.MaxVariadic}
+
+	for i, arg := range list.Args {
+		l.Args = append(l.Args, newArg(arg, i < len(list.Args)-list.Optional))
+	}
+
+	if list.Variadic != nil {
+		variadic := newArg(list.Variadic, list.MinVariadic > 0)
+		l.Variadic = &variadic
+	}
+
+	return l
+}
+
+func newArg(arg cli.Arg, required bool) Arg {
+	return Arg{Name: arg.Name(), Type: arg.TypeRepr(), Description: arg.Description(), Required: required}
+}
+
+func newFlags(flags []cli.Flag, persistent bool) []Flag {
+	var described []Flag
+
+	for _, flag := range flags {
+		f := Flag{
+			LongName:    flag.LongName(),
+			ShortName:   flag.ShortName(),
+			Type:        flag.TypeRepr(),
+			Description: flag.Description(),
+			Default:     mapper.FlagDefaultString(flag),
+			Persistent:  persistent,
+			Required:    mapper.FlagRequired(flag),
+			Hidden:      mapper.FlagHidden(flag),
+			Deprecation: mapper.FlagDeprecation(flag),
+			Env:         mapper.FlagEnvNames(flag),
+			Values:      mapper.FlagCandidates(flag),
+			Constraints: mapper.FlagConstraints(flag),
+		}
+
+		for _, alias := range mapper.FlagAliases(flag) {
+			f.Aliases = append(f.Aliases, FlagAlias{Name: alias.Name, Deprecated: alias.Deprecated})
+		}
+
+		described = append(described, f)
+	}
+
+	return described
+}
+
+// newHooks returns the hooks defined by the command, or nil if it does not define any.
+func newHooks(cmd cli.Command) *Hooks {
+	var hooks Hooks
+
+	if get, ok := cli.AsCommand[cli.CommandHook](cmd); ok {
+		if hook := get.Hook(); hook != nil {
+			hooks.BeforeCommandExecution = hook.BeforeCommandExecution != nil
+			hooks.AfterCommandExecution = hook.AfterCommandExecution != nil
+		}
+	}
+
+	if get, ok := cli.AsCommand[cli.CommandPersistentHook](cmd); ok {
+		if hook := get.PersistentHook(); hook != nil {
+			hooks.PersistentBeforeFlagsDefinition = hook.BeforeFlagsDefinition != nil
+			hooks.PersistentBeforeCommandExecution = hook.BeforeCommandExecution != nil
+			hooks.PersistentAfterCommandExecution = hook.AfterCommandExecution != nil
+		}
+	}
+
+	if hooks == (Hooks{}) {
+		return nil
+	}
+
+	return &hooks
+}
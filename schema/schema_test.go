@@ -0,0 +1,206 @@
+package clischema
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/krostar/test"
+	"github.com/krostar/test/check"
+
+	"github.com/krostar/cli"
+	"github.com/krostar/cli/double"
+	"github.com/krostar/cli/internal/clitest"
+)
+
+const expectedSchema = `{
+  "version": 1,
+  "command": {
+    "name": "app",
+    "description": "My application\n\nIt serves *things*.",
+    "flags": [
+      {
+        "longName": "verbose",
+        "shortName": "v",
+        "type": "bool",
+        "description": "Enable verbose logs",
+        "default": "false",
+        "persistent": true,
+        "env": [
+          "APP_VERBOSE"
+        ]
+      }
+    ],
+    "hooks": {
+      "persistentBeforeCommandExecution": true
+    },
+    "subCommands": [
+      {
+        "name": "serve",
+        "aliases": [
+          "run"
+        ],
+        "description": "Serve things\n.ini files are read",
+        "usage": "\u003ctarget\u003e [files...]",
+        "examples": [
+          "app serve --port 8080 api"
+        ],
+        "args": {
+          "positional": {
+            "args": [
+              {
+                "name": "target",
+                "type": "string",
+                "description": "Target to serve",
+                "required": true
+              }
+            ],
+            "variadic": {
+              "name": "files",
+              "type": "[]string",
+              "description": "Files to serve",
+              "required": true
+            },
+            "minVariadic": 1
+          }
+        },
+        "flags": [
+          {
+            "longName": "port",
+            "shortName": "p",
+            "type": "int",
+            "description": "Port to listen on",
+            "default": "8080",
+            "aliases": [
+              {
+                "name": "listen-port"
+              },
+              {
+                "name": "listen",
+                "deprecated": true
+              }
+            ],
+            "env": [
+              "APP_PORT",
+              "PORT"
+            ],
+            "constraints": [
+              "1-65535"
+            ]
+          },
+          {
+            "longName": "host",
+            "type": "string",
+            "description": "Host_name to listen on | interface",
+            "required": true
+          },
+          {
+            "longName": "log-level",
+            "type": "{info|debug}",
+            "description": "Log level",
+            "hidden": true,
+            "deprecation": "use --verbose",
+            "values": [
+              "info",
+              "debug"
+            ]
+          }
+        ],
+        "hooks": {
+          "afterCommandExecution": true
+        }
+      },
+      {
+        "name": "secret",
+        "hidden": true
+      },
+      {
+        "name": "old",
+        "hidden": true,
+        "deprecation": "use serve",
+        "subCommands": [
+          {
+            "name": "sub"
+          }
+        ]
+      }
+    ]
+  }
+}
+`
+
+func Test_New(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		c := clitest.NewCLI()
+		c.Name = "app"
+
+		schema, err := New(t.Context(), AddCommand(c))
+		test.Require(t, err == nil, err)
+		test.Assert(t, schema.Version == Version)
+		test.Assert(t, schema.Command.Name == "app")
+		test.Assert(t, len(schema.Command.SubCommands) == 3, "schema command is not part of the schema")
+		test.Assert(check.Compare(t, schema.Command.SubCommands[0].Hooks, &Hooks{AfterCommandExecution: true}))
+		test.Assert(t, schema.Command.SubCommands[1].Hooks == nil)
+	})
+
+	t.Run("hook failure", func(t *testing.T) {
+		c := cli.New(double.NewFake(double.FakeWithPersistentHook(func() *cli.PersistentHook {
+			return &cli.PersistentHook{BeforeFlagsDefinition: func(context.Context) error { return errors.New("boom") }}
+		})))
+
+		_, err := New(t.Context(), c)
+		test.Assert(t, err != nil && err.Error() == "unable to walk commands: pre-flag-definition hook of command  failed: boom", err)
+	})
+}
+
+func Test_Write(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		c := clitest.NewCLI()
+		c.Name = "app"
+
+		var buf bytes.Buffer
+		test.Require(t, Write(t.Context(), &buf, c) == nil)
+		test.Assert(check.Compare(t, buf.String(), expectedSchema))
+	})
+
+	t.Run("write failure", func(t *testing.T) {
+		err := Write(t.Context(), failingWriter{}, cli.New(double.NewFake()))
+		test.Assert(t, err != nil && errors.Is(err, errWrite), err)
+	})
+}
+
+var errWrite = errors.New("boom")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errWrite }
+
+func Test_Read(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		c := clitest.NewCLI()
+		c.Name = "app"
+
+		expected, err := New(t.Context(), c)
+		test.Require(t, err == nil, err)
+
+		schema, err := Read(strings.NewReader(expectedSchema))
+		test.Require(t, err == nil, err)
+		test.Assert(check.Compare(t, schema, expected))
+	})
+
+	for name, tc := range map[string]struct {
+		raw           string
+		expectedError string
+	}{
+		"invalid json":        {raw: `{`, expectedError: "unable to read schema: unexpected EOF"},
+		"unsupported version": {raw: `{"version":2,"command":{"name":"app"}}`, expectedError: "unsupported schema version 2, expected 1"},
+		"no command":          {raw: `{"version":1}`, expectedError: "schema does not describe any command"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tc.raw))
+			test.Assert(t, err != nil && err.Error() == tc.expectedError, err)
+		})
+	}
+}